@@ -0,0 +1,59 @@
+package dragontoothmg
+
+// Antichess (losing chess) support. Captures are compulsory, there is no check,
+// and the king is an ordinary piece: it can be captured, and pawns can promote
+// to it. Castling is not allowed. A side wins by losing all its pieces, or by
+// being stalemated.
+
+// The antichess rules.
+type antichessRules struct{ standardRules }
+
+func (antichessRules) Variant() Variant { return VariantAntichess }
+func (antichessRules) Startpos() string { return StartposAntichess }
+
+func (antichessRules) GenerateMoves(b *Board, piece Piece) []Move { return b.antichessMoves(piece) }
+
+// There is no check.
+func (antichessRules) InCheck(b *Board) bool { return false }
+
+// Losing all the pieces, or being stalemated, wins.
+func (antichessRules) Termination(b *Board, moveCount int) Termination {
+	if moveCount == 0 {
+		return TerminationAntichessWin
+	}
+	return TerminationNone
+}
+
+// Any pieces can still be forced to capture each other.
+func (antichessRules) InsufficientMaterial(b *Board) bool { return false }
+
+// Generates the antichess moves. If piece is not Nothing, only moves of that piece
+// are generated, though captures by other pieces still rule out its quiet moves.
+func (b *Board) antichessMoves(piece Piece) []Move {
+	moves := make([]Move, 0, kDefaultMoveListLength)
+	b.pseudoLegalMoves(&moves, Nothing)
+
+	// Pawns can promote to a king as well
+	hasCapture := false
+	for _, move := range moves {
+		if move.Promote() == Queen {
+			move.Setpromote(King)
+			moves = append(moves, move)
+		}
+		hasCapture = hasCapture || IsCapture(move, b)
+	}
+
+	legal := moves[:0]
+	for _, move := range moves {
+		if hasCapture && !IsCapture(move, b) {
+			continue
+		}
+		if piece != Nothing {
+			if pieceType, _ := b.PieceAt(Square(move.From())); pieceType != piece {
+				continue
+			}
+		}
+		legal = append(legal, move)
+	}
+	return legal
+}
@@ -0,0 +1,96 @@
+package dragontoothmg
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAntichessStartingPosition(t *testing.T) {
+	perftSolutions := map[int]int64{
+		1: 20,
+		2: 400,
+		3: 8067,
+		4: 153299,
+		5: 2732672,
+	}
+	checkVariantPerftResults(StartposAntichess, VariantAntichess, perftSolutions, t)
+}
+
+func TestAntichessEndgames(t *testing.T) {
+	checkVariantPerftResults("8/1p6/8/8/8/8/P7/8 w - - 0 1",
+		VariantAntichess, map[int]int64{1: 2, 2: 4, 3: 4, 4: 3}, t)
+	checkVariantPerftResults("8/2p5/8/8/8/8/1P1P4/8 w - - 0 1",
+		VariantAntichess, map[int]int64{1: 4, 2: 8, 3: 20, 4: 18}, t)
+}
+
+func TestAntichessMoves(t *testing.T) {
+	tests := []struct {
+		fen     string
+		legal   []string
+		illegal []string
+	}{
+		// Captures are compulsory, also for the king
+		{"4k3/8/8/8/8/8/4p3/4K3 w - - 0 1", []string{"e1e2"}, []string{"e1d1", "e1f2"}},
+		// Moving into check is fine, and there is no castling
+		{"4k3/8/8/8/8/8/8/R3K2R w KQ - 0 1", []string{"e1e2", "a1a8"}, []string{"e1g1", "e1c1"}},
+		// Pawns promote to kings too
+		{"8/P7/8/8/8/8/8/7k w - - 0 1", []string{"a7a8k", "a7a8q", "a7a8n"}, nil},
+		// En passant counts as a capture
+		{"8/8/8/3Pp3/8/8/8/R7 w - e6 0 1", []string{"d5e6"}, []string{"d5d6", "a1a2"}},
+	}
+	for _, test := range tests {
+		b := ParseFenVariant(test.fen, VariantAntichess)
+		moves := b.GenerateLegalMoves()
+		for _, str := range test.legal {
+			if !slices.Contains(moves, parseMove(str)) {
+				t.Error("Missing move", str, "in", test.fen)
+			}
+		}
+		for _, str := range test.illegal {
+			if slices.Contains(moves, parseMove(str)) {
+				t.Error("Illegal move", str, "in", test.fen)
+			}
+		}
+	}
+
+	// A rook capture rules out the king's quiet moves
+	b := ParseFenVariant("4k3/8/8/8/8/8/r7/R3K3 w - - 0 1", VariantAntichess)
+	if moves := b.GenerateMovesForPiece(King); len(moves) != 0 {
+		t.Error("Expected no king moves, got", moves)
+	}
+	if moves := b.GenerateMovesForPiece(Rook); len(moves) != 1 || moves[0] != parseMove("a1a2") {
+		t.Error("Expected only the rook capture, got", moves)
+	}
+
+	// Promoting to a king, and capturing it
+	b = ParseFenVariant("8/P7/8/8/8/8/8/r7 w - - 0 1", VariantAntichess)
+	b.Make(parseMove("a7a8k"))
+	b.Make(parseMove("a1a8"))
+	if b.ToFen() != "r7/8/8/8/8/8/8/8 w - - 0 2" {
+		t.Error("Unexpected position", b.ToFen())
+	}
+	b.Undo()
+	b.Undo()
+	if b.ToFen() != "8/P7/8/8/8/8/8/r7 w - - 0 1" {
+		t.Error("Undo failed:", b.ToFen())
+	}
+}
+
+func TestAntichessTermination(t *testing.T) {
+	fens := []string{
+		"r7/8/8/8/8/8/8/8 w - - 0 2",  // no pieces left
+		"8/8/8/8/8/p7/P7/8 w - - 0 1", // stalemate
+		"8/8/8/8/8/8/8/k6K w - - 0 1", // kings alone aren't a draw
+	}
+	terminated := []bool{true, true, false}
+	for i, fen := range fens {
+		b := ParseFenVariant(fen, VariantAntichess)
+		moves := b.GenerateLegalMoves()
+		if b.IsTerminated(len(moves)) != terminated[i] {
+			t.Error("Wrong termination for", fen, b.Termination())
+		}
+		if terminated[i] && b.Termination() != TerminationAntichessWin {
+			t.Error("Expected an antichess win for", fen, "got", b.Termination())
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package dragontoothmg
 
+import "math/bits"
+
 // Applies a move to the board, and returns a function that can be used to unapply it.
 // This function assumes that the given move is valid (i.e., is in the set of moves found by GenerateLegalMoves()).
 // If the move is not valid, this function has undefined behavior.
@@ -17,15 +19,39 @@ func (b *Board) Apply(m Move) func() {
 // Makes a move on the board. This function assumes that the given move is valid (i.e., is in the set of moves found by GenerateLegalMoves()).
 // If the move is not valid, this function has undefined behavior.
 func (b *Board) Make(m Move) {
+	if m.IsDrop() {
+		b.makeDrop(m)
+		return
+	}
+	b.makeMove(b.MoveInfo(m))
+}
+
+// Makes a move given with its information, such as from GenerateMoveInfos(). The
+// moving and captured pieces and the kind of the move are taken from the info
+// instead of being derived again. The info must be of a valid move in the position.
+func (b *Board) MakeMoveInfo(mi MoveInfo) {
+	if mi.Kind() == MoveKindDrop {
+		b.makeDrop(mi.Move())
+		return
+	}
+	b.makeMove(mi)
+}
+
+// Makes an ordinary (non-drop) move, given with its information.
+func (b *Board) makeMove(mi MoveInfo) {
+	m := mi.Move()
+	kind := mi.Kind()
 
 	// Configure data about which pieces move
 	hashBefore := b.hash
+	pawnHashBefore, materialKeyBefore := b.pawnHash, b.materialKey
 	var ourBitboardPtr, oppBitboardPtr *Bitboards
 	var epDelta int8                                // add this to the e.p. square to find the captured pawn
 	var oppStartingRankBb, ourStartingRankBb uint64 // the starting rank of out opponent's major pieces
 	// the constant that represents the index into pieceSquareZobristC for the pawn of our color
 	var ourPiecesPawnZobristIndex int
 	var oppPiecesPawnZobristIndex int
+	var ourColorBit uint8 // the color bit of our pieces in the mailbox
 	if b.Wtomove {
 		ourBitboardPtr = &(b.White)
 		oppBitboardPtr = &(b.Black)
@@ -43,19 +69,33 @@ func (b *Board) Make(m Move) {
 		b.Fullmoveno++ // increment after black's move
 		ourPiecesPawnZobristIndex = 6
 		oppPiecesPawnZobristIndex = 0
+		ourColorBit = blackPieceBit
 	}
 	fromBitboard := (uint64(1) << m.From())
 	toBitboard := (uint64(1) << m.To())
-	pieceType, pieceTypeBitboard := DeterminePieceType(ourBitboardPtr, fromBitboard)
+	pieceType := mi.Piece()
+	pieceTypeBitboard := ourBitboardPtr.pieceBitboard(pieceType)
+	capturedPieceType := mi.Captured()
+	if kind == MoveKindEnPassant {
+		capturedPieceType = Nothing // the captured pawn is not on the target square
+	}
 	castleStatus := 0
-
 	var oldRookLoc, newRookLoc uint8
-	var flippedKsCastle, flippedQsCastle, flippedOppKsCastle, flippedOppQsCastle bool
+
+	// Save the state that Undo can't recompute
+	h := History{
+		hashBefore:         hashBefore,
+		pawnHashBefore:     pawnHashBefore,
+		materialKeyBefore:  materialKeyBefore,
+		Move:               m,
+		capturedPieceType:  capturedPieceType,
+		oldCastleRights:    b.castlerights,
+		oldEpCaptureSquare: b.enpassant,
+		oldHalfmoveClock:   b.Halfmoveclock,
+	}
 
 	// If it is any kind of capture or pawn move, reset halfmove clock.
-	resetHalfmoveClockFrom := -1
-	if IsCapture(m, b) || pieceType == Pawn {
-		resetHalfmoveClockFrom = int(b.Halfmoveclock)
+	if capturedPieceType != Nothing || pieceType == Pawn {
 		b.Halfmoveclock = 0 // reset halfmove clock
 	} else {
 		b.Halfmoveclock++
@@ -63,12 +103,12 @@ func (b *Board) Make(m Move) {
 
 	// King moves strip castling rights
 	if pieceType == King {
-		// TODO(dylhunn): do this without a branch
-		if m.To()-m.From() == 2 { // castle short
+		switch kind {
+		case MoveKindCastleKingside:
 			castleStatus = 1
 			oldRookLoc = m.To() + 1
 			newRookLoc = m.To() - 1
-		} else if int(m.To())-int(m.From()) == -2 { // castle long
+		case MoveKindCastleQueenside:
 			castleStatus = -1
 			oldRookLoc = m.To() - 2
 			newRookLoc = m.To() + 1
@@ -76,11 +116,9 @@ func (b *Board) Make(m Move) {
 		// King moves always strip castling rights
 		if b.CanCastleKingside() {
 			b.flipKingsideCastle()
-			flippedKsCastle = true
 		}
 		if b.CanCastleQueenside() {
 			b.flipQueensideCastle()
-			flippedQsCastle = true
 		}
 	}
 
@@ -88,11 +126,9 @@ func (b *Board) Make(m Move) {
 	if pieceType == Rook {
 		if b.CanCastleKingside() && (fromBitboard&onlyFile[7] != 0) &&
 			fromBitboard&ourStartingRankBb != 0 { // king's rook
-			flippedKsCastle = true
 			b.flipKingsideCastle()
 		} else if b.CanCastleQueenside() && (fromBitboard&onlyFile[0] != 0) &&
 			fromBitboard&ourStartingRankBb != 0 { // queen's rook
-			flippedQsCastle = true
 			b.flipQueensideCastle()
 		}
 	}
@@ -103,6 +139,8 @@ func (b *Board) Make(m Move) {
 		ourBitboardPtr.All |= (uint64(1) << newRookLoc)
 		ourBitboardPtr.Rooks &= ^(uint64(1) << oldRookLoc)
 		ourBitboardPtr.All &= ^(uint64(1) << oldRookLoc)
+		b.mailbox[oldRookLoc] = 0
+		b.mailbox[newRookLoc] = Rook | ourColorBit
 		// Update rook location in hash
 		// (Rook - 1) assumes that "Nothing" precedes "Rook" in the Piece constants list
 		b.hash ^= pieceSquareZobristC[ourPiecesPawnZobristIndex+(Rook-1)][oldRookLoc]
@@ -111,15 +149,18 @@ func (b *Board) Make(m Move) {
 
 	// Is this an e.p. capture? Strip the opponent pawn and reset the e.p. square
 	oldEpCaptureSquare := b.enpassant
-	if pieceType == Pawn && m.To() == oldEpCaptureSquare && oldEpCaptureSquare != 0 {
+	if kind == MoveKindEnPassant {
 		epOpponentPawnLocation := uint8(int8(oldEpCaptureSquare) + epDelta)
 		oppBitboardPtr.Pawns &= ^(uint64(1) << epOpponentPawnLocation)
 		oppBitboardPtr.All &= ^(uint64(1) << epOpponentPawnLocation)
+		b.mailbox[epOpponentPawnLocation] = 0
 		// Remove the opponent pawn from the board hash.
 		b.hash ^= pieceSquareZobristC[oppPiecesPawnZobristIndex][epOpponentPawnLocation]
+		b.pawnHash ^= pieceSquareZobristC[oppPiecesPawnZobristIndex][epOpponentPawnLocation]
+		b.materialKey ^= materialZobristC[oppPiecesPawnZobristIndex][bits.OnesCount64(oppBitboardPtr.Pawns)]
 	}
 	// Update the en passant square
-	if pieceType == Pawn && (int8(m.To())+2*epDelta == int8(m.From())) { // pawn double push
+	if kind == MoveKindDoublePush {
 		b.enpassant = uint8(int8(m.To()) + epDelta)
 	} else {
 		b.enpassant = 0
@@ -141,33 +182,51 @@ func (b *Board) Make(m Move) {
 	case Bishop:
 		destTypeBitboard = &(ourBitboardPtr.Bishops)
 		promotedToPieceType = Bishop
+	case King: // antichess only
+		destTypeBitboard = &(ourBitboardPtr.Kings)
+		promotedToPieceType = King
 	default:
 		destTypeBitboard = pieceTypeBitboard
 		promotedToPieceType = pieceType
 	}
 
 	// Apply the move
-	capturedPieceType, capturedBitboard := DeterminePieceType(oppBitboardPtr, toBitboard)
 	ourBitboardPtr.All &= ^fromBitboard // remove at "from"
 	ourBitboardPtr.All |= toBitboard    // add at "to"
 	*pieceTypeBitboard &= ^fromBitboard // remove at "from"
 	*destTypeBitboard |= toBitboard     // add at "to"
-	if capturedPieceType != Nothing {   // This does not account for e.p. captures
+	b.mailbox[m.From()] = 0
+	b.mailbox[m.To()] = uint8(promotedToPieceType) | ourColorBit
+	if capturedPieceType != Nothing {
+		capturedBitboard := oppBitboardPtr.pieceBitboard(capturedPieceType)
 		*capturedBitboard &= ^toBitboard
 		oppBitboardPtr.All &= ^toBitboard
 		b.hash ^= pieceSquareZobristC[oppPiecesPawnZobristIndex+(int(capturedPieceType)-1)][m.To()] // remove the captured piece from the hash
+		b.materialKey ^= materialZobristC[oppPiecesPawnZobristIndex+(int(capturedPieceType)-1)][bits.OnesCount64(*capturedBitboard)]
+		if capturedPieceType == Pawn {
+			b.pawnHash ^= pieceSquareZobristC[oppPiecesPawnZobristIndex][m.To()]
+		}
 	}
 	b.hash ^= pieceSquareZobristC[(int(pieceType)-1)+ourPiecesPawnZobristIndex][m.From()]         // remove piece at "from"
 	b.hash ^= pieceSquareZobristC[(int(promotedToPieceType)-1)+ourPiecesPawnZobristIndex][m.To()] // add piece at "to"
 
+	// Update the pawn hash, and the material key on promotion
+	if pieceType == Pawn {
+		b.pawnHash ^= pieceSquareZobristC[ourPiecesPawnZobristIndex][m.From()]
+		if promotedToPieceType == Pawn {
+			b.pawnHash ^= pieceSquareZobristC[ourPiecesPawnZobristIndex][m.To()]
+		} else {
+			b.materialKey ^= materialZobristC[ourPiecesPawnZobristIndex][bits.OnesCount64(ourBitboardPtr.Pawns)]
+			b.materialKey ^= materialZobristC[ourPiecesPawnZobristIndex+(int(promotedToPieceType)-1)][bits.OnesCount64(*destTypeBitboard)-1]
+		}
+	}
+
 	// If a rook was captured, it strips castling rights
 	if capturedPieceType == Rook {
 		if m.To()%8 == 7 && toBitboard&oppStartingRankBb != 0 && b.OppCanCastleKingside() { // captured king rook
 			b.flipOppKingsideCastle()
-			flippedOppKsCastle = true
 		} else if m.To()%8 == 0 && toBitboard&oppStartingRankBb != 0 && b.OppCanCastleQueenside() { // queen rooks
 			b.flipOppQueensideCastle()
-			flippedOppQsCastle = true
 		}
 	}
 	// flip the side to move in the hash
@@ -178,26 +237,15 @@ func (b *Board) Make(m Move) {
 	b.hash ^= uint64(oldEpCaptureSquare)
 	b.hash ^= uint64(b.enpassant)
 
-	// Set all undo fields, there are probably some redundant ones here
-	// but it makes the code simpler to read and write
-	// (and uses negligible memory)
-	h := History{}
-	h.capturedBitboard = capturedBitboard
-	h.capturedPieceType = capturedPieceType
-	h.castleStatus = castleStatus
-	h.flippedKsCastle = flippedKsCastle
-	h.flippedQsCastle = flippedQsCastle
-	h.flippedOppKsCastle = flippedOppKsCastle
-	h.flippedOppQsCastle = flippedOppQsCastle
-	h.Move = m
-	h.newRookLoc = newRookLoc
-	h.oldEpCaptureSquare = oldEpCaptureSquare
-	h.oldRookLoc = oldRookLoc
-	h.resetHalfmoveClockFrom = resetHalfmoveClockFrom
-	h.hashBefore = hashBefore
 	h.hashCurrent = b.hash
-
 	b.History = append(b.History, h)
+
+	// Apply the side effects of the variant
+	if b.rules != nil {
+		u := &b.History[len(b.History)-1]
+		b.rules.Make(b, u, mi.Captured())
+		u.hashCurrent = b.hash
+	}
 }
 
 // Undoes the last move. If there is no move to undo, this function does nothing.
@@ -208,35 +256,44 @@ func (b *Board) Undo() {
 	}
 	b.termination = TerminationNone
 	u := &b.History[len(b.History)-1]
+	if u.Move.IsDrop() {
+		b.undoDrop(u)
+		return
+	}
+	// Revert the side effects of the variant
+	if b.rules != nil {
+		b.rules.Undo(b, u)
+	}
 	// Configure data about which pieces move
 	var ourBitboardPtr, oppBitboardPtr *Bitboards
 	var epDelta int8 // add this to the e.p. square to find the captured pawn
-	// the constant that represents the index into pieceSquareZobristC for the pawn of our color
+	// the color bits of our and the opponent's pieces in the mailbox
+	var ourColorBit, oppColorBit uint8
 	if !b.Wtomove {
 		ourBitboardPtr = &(b.White)
 		oppBitboardPtr = &(b.Black)
 		epDelta = -8
+		oppColorBit = blackPieceBit
 	} else {
 		ourBitboardPtr = &(b.Black)
 		oppBitboardPtr = &(b.White)
 		epDelta = 8
+		ourColorBit = blackPieceBit
 	}
 
 	// Flip the player to move
 	b.Wtomove = !b.Wtomove
 
-	// Restore the halfmove clock
-	if u.resetHalfmoveClockFrom == -1 {
-		b.Halfmoveclock--
-	} else {
-		b.Halfmoveclock = uint8(u.resetHalfmoveClockFrom)
-	}
+	// Restore the halfmove clock and the castling rights
+	b.Halfmoveclock = u.oldHalfmoveClock
+	b.castlerights = u.oldCastleRights
 
 	fromBitboard := uint64(1) << u.Move.From()
 	toBitboard := uint64(1) << u.Move.To()
 
 	// Get the pice type that moved
-	pieceType, pieceTypeBitboard := DeterminePieceType(ourBitboardPtr, toBitboard)
+	pieceType := Piece(b.mailbox[u.Move.To()] & 7)
+	pieceTypeBitboard := ourBitboardPtr.pieceBitboard(pieceType)
 
 	// Is this a promotion?
 	var destTypeBitboard *uint64
@@ -257,6 +314,9 @@ func (b *Board) Undo() {
 		destTypeBitboard = &(ourBitboardPtr.Bishops)
 		pieceTypeBitboard = &(ourBitboardPtr.Pawns)
 		// promotedToPieceType = Bishop
+	case King: // antichess only
+		destTypeBitboard = &(ourBitboardPtr.Kings)
+		pieceTypeBitboard = &(ourBitboardPtr.Pawns)
 	default:
 		destTypeBitboard = pieceTypeBitboard
 		// promotedToPieceType = pieceType
@@ -267,18 +327,30 @@ func (b *Board) Undo() {
 	ourBitboardPtr.All |= fromBitboard // add at "from"
 	*destTypeBitboard &= ^toBitboard   // remove at "to"
 	*pieceTypeBitboard |= fromBitboard // add at "from"
+	if u.Move.Promote() != Nothing {
+		pieceType = Pawn
+	}
+	b.mailbox[u.Move.From()] = uint8(pieceType) | ourColorBit
+	b.mailbox[u.Move.To()] = 0
 	// Restore captured piece (excluding e.p.)
 	if u.capturedPieceType != Nothing { // doesn't consider e.p. captures
-		*u.capturedBitboard |= toBitboard
+		*oppBitboardPtr.pieceBitboard(u.capturedPieceType) |= toBitboard
 		oppBitboardPtr.All |= toBitboard
+		b.mailbox[u.Move.To()] = uint8(u.capturedPieceType) | oppColorBit
 	}
 
 	// Restore rooks from castling move
-	if u.castleStatus != 0 {
-		ourBitboardPtr.Rooks &= ^(uint64(1) << u.newRookLoc)
-		ourBitboardPtr.All &= ^(uint64(1) << u.newRookLoc)
-		ourBitboardPtr.Rooks |= (uint64(1) << u.oldRookLoc)
-		ourBitboardPtr.All |= (uint64(1) << u.oldRookLoc)
+	if pieceType == King && (u.Move.To()-u.Move.From() == 2 || int(u.Move.To())-int(u.Move.From()) == -2) {
+		oldRookLoc, newRookLoc := u.Move.To()+1, u.Move.To()-1
+		if u.Move.To() < u.Move.From() { // castle long
+			oldRookLoc, newRookLoc = u.Move.To()-2, u.Move.To()+1
+		}
+		ourBitboardPtr.Rooks &= ^(uint64(1) << newRookLoc)
+		ourBitboardPtr.All &= ^(uint64(1) << newRookLoc)
+		ourBitboardPtr.Rooks |= (uint64(1) << oldRookLoc)
+		ourBitboardPtr.All |= (uint64(1) << oldRookLoc)
+		b.mailbox[newRookLoc] = 0
+		b.mailbox[oldRookLoc] = Rook | ourColorBit
 	}
 
 	// Unapply en-passant square change, and capture if necessary
@@ -287,6 +359,7 @@ func (b *Board) Undo() {
 		epOpponentPawnLocation := uint8(int8(u.oldEpCaptureSquare) + epDelta)
 		oppBitboardPtr.Pawns |= (uint64(1) << epOpponentPawnLocation)
 		oppBitboardPtr.All |= (uint64(1) << epOpponentPawnLocation)
+		b.mailbox[epOpponentPawnLocation] = Pawn | oppColorBit
 	}
 
 	// Decrement move clock
@@ -294,23 +367,10 @@ func (b *Board) Undo() {
 		b.Fullmoveno-- // decrement after undoing black's move
 	}
 
-	// Restore castling flags
-	// Must update castling flags AFTER turn swap
-	if u.flippedKsCastle {
-		b.flipKingsideCastle()
-	}
-	if u.flippedQsCastle {
-		b.flipQueensideCastle()
-	}
-	if u.flippedOppKsCastle {
-		b.flipOppKingsideCastle()
-	}
-	if u.flippedOppQsCastle {
-		b.flipOppQueensideCastle()
-	}
-
-	// Reset the hash and reslice the history
+	// Reset the hashes and reslice the history
 	b.hash = u.hashBefore
+	b.pawnHash = u.pawnHashBefore
+	b.materialKey = u.materialKeyBefore
 	b.History = b.History[:len(b.History)-1]
 }
 
@@ -328,8 +388,8 @@ func (b *Board) MakeNullMove() {
 	b.Wtomove = !b.Wtomove
 	b.hash ^= whiteToMoveZobristC
 
-	b.History = append(b.History,
-		History{hashBefore: hashBefore, oldEpCaptureSquare: oldEpCaptureSquare})
+	b.History = append(b.History, History{hashBefore: hashBefore, oldEpCaptureSquare: oldEpCaptureSquare,
+		oldCastleRights: b.castlerights, oldHalfmoveClock: b.Halfmoveclock})
 }
 
 func (b *Board) UndoNullMove() {
@@ -2,6 +2,7 @@ package dragontoothmg
 
 import (
 	"testing"
+	"unsafe"
 )
 
 // Test that two different sequences of moves involving en passant but leading to the same board have the same result
@@ -121,6 +122,10 @@ func TestApplyUnapply(t *testing.T) {
 			t.Error("Move apply changed board hash from expected result",
 				"\nwith move", &v)
 		}
+		if b.PawnHash() != recomputePawnHash(&b) || b.MaterialKey() != recomputeMaterialKey(&b) {
+			t.Error("Move apply changed pawn hash or material key from expected result",
+				"\nwith move", &v)
+		}
 		unapply()
 		newHash := b.Hash()
 		if oldHash != newHash {
@@ -131,6 +136,10 @@ func TestApplyUnapply(t *testing.T) {
 			t.Error("(1) Move unapply (or previous apply) changed board hash for:\n",
 				b.ToFen(), "\nwith move", &v)
 		}
+		if b.PawnHash() != recomputePawnHash(&b) || b.MaterialKey() != recomputeMaterialKey(&b) {
+			t.Error("Move unapply changed pawn hash or material key for:\n",
+				b.ToFen(), "\nwith move", &v)
+		}
 		if k != b.ToFen() {
 			t.Error("Board changed during unapply for\n", k, "\nResult was\n", b.ToFen(),
 				"\nwith move", &v)
@@ -159,3 +168,224 @@ func TestApplyUnapply(t *testing.T) {
 		}*/
 	}
 }
+
+// Walk a small tree and verify the incremental keys against the recomputed ones
+func TestPawnHashMaterialKey(t *testing.T) {
+	positions := []string{
+		Startpos,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0",
+		"n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1",
+		"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1",
+		"rnbqkbnr/ppp1pppp/8/8/2Pp4/8/PP1PPPPP/RNBQKBNR b KQkq c3 0 1",
+	}
+	var walk func(b *Board, depth int)
+	walk = func(b *Board, depth int) {
+		if b.PawnHash() != recomputePawnHash(b) {
+			t.Error("Incremental pawn hash differs for", b.ToFen())
+		}
+		if b.MaterialKey() != recomputeMaterialKey(b) {
+			t.Error("Incremental material key differs for", b.ToFen())
+		}
+		if depth == 0 {
+			return
+		}
+		for _, m := range b.GenerateLegalMoves() {
+			b.Make(m)
+			walk(b, depth-1)
+			b.Undo()
+		}
+	}
+	for _, fen := range positions {
+		b := ParseFen(fen)
+		walk(&b, 3)
+	}
+}
+
+func TestMaterialKeyTranspositions(t *testing.T) {
+	b1 := ParseFen("4k3/8/8/8/8/8/8/R3K2R w - - 0 1")
+	b2 := ParseFen("3k4/8/8/8/8/8/8/1R2K1R1 b - - 0 1")
+	if b1.MaterialKey() != b2.MaterialKey() {
+		t.Error("Same material produced different material keys")
+	}
+	b3 := ParseFen("4k3/8/8/8/8/8/8/R3K2Q w - - 0 1")
+	if b1.MaterialKey() == b3.MaterialKey() {
+		t.Error("Different material produced the same material key")
+	}
+	b4 := ParseFen("4k3/8/8/8/8/8/4P3/R3K2R w - - 0 1")
+	if b1.PawnHash() == b4.PawnHash() || b1.PawnHash() != 0 {
+		t.Error("Unexpected pawn hash")
+	}
+}
+
+func TestMaterialSignature(t *testing.T) {
+	signatures := map[string]string{
+		Startpos:                             "KQRRBBNNPPPPPPPPvKQRRBBNNPPPPPPPP",
+		"8/8/4k3/3r4/8/1KR5/1P6/8 w - - 0 1": "KRPvKR",
+		"8/8/4k3/8/8/1K6/8/8 w - - 0 1":      "KvK",
+		"8/8/4k3/8/8/1KBN4/8/8 b - - 0 1":    "KBNvK",
+	}
+	for fen, sig := range signatures {
+		b := ParseFen(fen)
+		if b.MaterialSignature() != sig {
+			t.Errorf("MaterialSignature() = %s; want %s (fen: %s)", b.MaterialSignature(), sig, fen)
+		}
+	}
+}
+
+// Returns whether the incremental mailbox matches the bitboards
+func mailboxInSync(b *Board) bool {
+	synced := *b
+	synced.SyncMailbox()
+	return synced.mailbox == b.mailbox
+}
+
+func TestMailbox(t *testing.T) {
+	square := func(alg string) Square {
+		sq, _ := AlgebraicToIndex(alg)
+		return Square(sq)
+	}
+	b := ParseFen("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0")
+	if p, c := b.PieceAt(square("e2")); p != Bishop || c != White {
+		t.Error("Expected a white bishop on e2, got", p, c)
+	}
+	if p, c := b.PieceAt(square("e7")); p != Queen || c != Black {
+		t.Error("Expected a black queen on e7, got", p, c)
+	}
+	if p, _ := b.PieceAt(square("e4")); p != Pawn {
+		t.Error("Expected a pawn on e4, got", p)
+	}
+	if p, _ := b.PieceAt(square("d4")); p != Nothing {
+		t.Error("Expected an empty d4, got", p)
+	}
+
+	// Castling, en passant and promotions, made and undone
+	positions := []string{
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0",
+		"n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1",
+		"rnbqkbnr/ppp1pppp/8/8/2Pp4/8/PP1PPPPP/RNBQKBNR b KQkq c3 0 1",
+	}
+	var walk func(b *Board, depth int)
+	walk = func(b *Board, depth int) {
+		if !mailboxInSync(b) {
+			t.Fatal("Mailbox out of sync in", b.ToFen())
+		}
+		if depth == 0 {
+			return
+		}
+		for _, m := range b.GenerateLegalMoves() {
+			b.Make(m)
+			walk(b, depth-1)
+			b.Undo()
+		}
+	}
+	for _, fen := range positions {
+		b := ParseFen(fen)
+		walk(&b, 3)
+	}
+}
+
+// A board built from its bitboards, without syncing the mailbox
+func TestUnsyncedMailbox(t *testing.T) {
+	parsed := ParseFen("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w - - 0 0")
+	b := Board{Wtomove: true, White: parsed.White, Black: parsed.Black}
+	if p, c := b.PieceAt(18); p != Knight || c != White {
+		t.Error("Expected a white knight on c3, got", p, c)
+	}
+	if p, isWhite := GetPieceType(52, &b); p != Queen || isWhite {
+		t.Error("Expected a black queen on e7, got", p, isWhite)
+	}
+	m := parseMove("e5f7")
+	if !IsCapture(m, &b) || IsCapture(parseMove("e5d3"), &b) {
+		t.Error("Wrong captures on a board with an unsynced mailbox")
+	}
+	if !b.IsPseudoLegal(m) {
+		t.Error("Expected e5f7 to be pseudo-legal")
+	}
+	if b.mailbox != [64]uint8{} {
+		t.Error("A query modified the mailbox")
+	}
+	b.SyncMailbox()
+	b.Make(m)
+	if p, c := b.PieceAt(53); p != Knight || c != White || !mailboxInSync(&b) {
+		t.Error("Expected a white knight on f7 and a synced mailbox, got", p, c)
+	}
+}
+
+// Undoing moves on a clone must not change the original board
+func TestCloneUndo(t *testing.T) {
+	b := ParseFen("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0")
+	for _, m := range []string{"e2a6", "b4c3", "e5f7", "e8c8"} {
+		b.Make(parseMove(m))
+	}
+	fen, hash := b.ToFen(), b.Hash()
+	clone := b.Clone()
+	for i := 0; i < 4; i++ {
+		clone.Undo()
+	}
+	if clone.ToFen() != "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0" {
+		t.Error("Undo on the clone failed:", clone.ToFen())
+	}
+	if b.ToFen() != fen || b.Hash() != hash || !mailboxInSync(&b) {
+		t.Error("Undo on the clone changed the original board:", b.ToFen())
+	}
+	b.Undo()
+	if b.ToFen() != "r3k2r/p1ppqNb1/Bn2pnp1/3P4/4P3/2p2Q1p/PPPB1PPP/R3K2R b KQkq - 0 1" {
+		t.Error("Undo on the original board failed:", b.ToFen())
+	}
+}
+
+// The undo records of the variants fit in the history entries without growing them
+// past the size they had before the variants.
+func TestHistorySize(t *testing.T) {
+	if size := unsafe.Sizeof(History{}); size > 64 {
+		t.Error("History entries take", size, "bytes, more than 64")
+	}
+}
+
+// Clones, copies and boards sharing the same rules undo their own variant moves
+func TestVariantCloneUndo(t *testing.T) {
+	for _, variant := range []Variant{VariantCrazyhouse, VariantAtomic, VariantThreeCheck} {
+		rules := variant.Rules()
+		b := NewBoardRules(rules)
+		other := NewBoardRules(rules)
+		start := b.ToFen()
+		for _, m := range []string{"e2e4", "d7d5", "e4d5", "d8d5", "f1b5"} {
+			b.Make(parseMove(m))
+		}
+		fen := b.ToFen()
+		clone := b.Clone()
+		other.Make(parseMove("g1f3"))
+		for i := 0; i < 5; i++ {
+			clone.Undo()
+		}
+		if clone.ToFen() != start {
+			t.Error("Undo on the", variant, "clone failed:", clone.ToFen())
+		}
+		if b.ToFen() != fen {
+			t.Error("Undo on the", variant, "clone changed the original board:", b.ToFen())
+		}
+		for i := 0; i < 5; i++ {
+			b.Undo()
+		}
+		other.Undo()
+		if b.ToFen() != other.ToFen() || b.ToFen() != clone.ToFen() {
+			t.Error("Undo on the original", variant, "board failed:", b.ToFen(), other.ToFen())
+		}
+
+		// Boards copied by value undo their own moves too: a capture, then a check
+		for _, m := range []string{"e2e4", "d7d5", "e4d5", "c7c6", "f1b5", "c6b5"} {
+			fen := b.ToFen()
+			b.Make(parseMove(m))
+			copied := *b
+			for _, reply := range copied.GenerateLegalMoves() {
+				copied.Make(reply)
+				break
+			}
+			b.Undo()
+			if b.ToFen() != fen {
+				t.Error("Undo on the", variant, "board copied by value failed:", b.ToFen())
+			}
+			b.Make(parseMove(m))
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package dragontoothmg
+
+// Atomic chess support. Every capture explodes the capturing piece, the captured
+// piece and all the non-pawn pieces on the squares around the capture square.
+// Kings can't capture, a move may not explode our own king, and adjacent kings
+// don't give check to each other, since taking the king would explode both.
+// Exploding the enemy king wins the game.
+
+import "math/bits"
+
+// The atomic chess rules.
+type atomicRules struct{ standardRules }
+
+func (atomicRules) Variant() Variant { return VariantAtomic }
+
+func (atomicRules) GenerateMoves(b *Board, piece Piece) []Move { return b.atomicMoves(piece) }
+func (atomicRules) InCheck(b *Board) bool                      { return b.atomicKingInCheck(b.Wtomove) }
+
+// A capture explodes the capturing piece and the pieces around it.
+func (atomicRules) Make(b *Board, h *History, captured Piece) {
+	if captured != Nothing {
+		h.explodedSquares, h.explodedPieces = b.explode(h.Move.To())
+	}
+}
+
+// Puts back the exploded pieces. Undo restores the castling rights.
+func (atomicRules) Undo(b *Board, h *History) {
+	if h.explodedSquares != 0 {
+		b.unexplode(h.explodedSquares, h.explodedPieces)
+	}
+}
+
+func (atomicRules) Termination(b *Board, moveCount int) Termination {
+	if b.White.Kings == 0 || b.Black.Kings == 0 {
+		return TerminationKingExploded
+	}
+	return TerminationNone
+}
+
+// Only bare kings can't win, since a king can be exploded or captured.
+func (atomicRules) InsufficientMaterial(b *Board) bool {
+	return b.White.All == b.White.Kings && b.Black.All == b.Black.Kings
+}
+
+// King and rook squares of each castling right, in the order of the castle rights bits.
+var castleRightSquares = [4]uint64{
+	1<<4 | 1<<0, 1<<4 | 1<<7, 1<<60 | 1<<56, 1<<60 | 1<<63,
+}
+
+// Generates the legal atomic moves. If piece is not Nothing, only moves of that piece are generated.
+// Every pseudo-legal move is made, and kept if it doesn't explode or expose our king.
+func (b *Board) atomicMoves(piece Piece) []Move {
+	moves := make([]Move, 0, b.getMoveListLength(piece))
+	var ourPieces, oppPieces *Bitboards
+	if b.Wtomove {
+		ourPieces, oppPieces = &(b.White), &(b.Black)
+	} else {
+		ourPieces, oppPieces = &(b.Black), &(b.White)
+	}
+	if ourPieces.Kings == 0 || oppPieces.Kings == 0 { // the game is over
+		return moves
+	}
+
+	b.pseudoLegalMoves(&moves, piece)
+	inCheck := b.atomicKingInCheck(b.Wtomove)
+	if (piece == Nothing || piece == King) && !inCheck {
+		b.castlingCandidates(&moves)
+	}
+
+	// Undo resets the termination, which may have been computed already
+	termination := b.termination
+	legal := moves[:0]
+	for _, move := range moves {
+		fromBitboard := uint64(1) << move.From()
+		if fromBitboard&ourPieces.Kings != 0 {
+			// kings can't capture
+			if (uint64(1)<<move.To())&oppPieces.All != 0 {
+				continue
+			}
+			// the king can't castle through an attacked square
+			if delta := int(move.To()) - int(move.From()); delta == 2 || delta == -2 {
+				transit := uint8(int(move.From()) + delta/2)
+				if kingMasks[transit]&oppPieces.Kings == 0 && b.UnderDirectAttack(b.Wtomove, transit) {
+					continue
+				}
+			}
+		}
+		b.Make(move)
+		// b.Wtomove is now the opponent
+		if ourPieces.Kings != 0 && (oppPieces.Kings == 0 || !b.atomicKingInCheck(!b.Wtomove)) {
+			legal = append(legal, move)
+		}
+		b.Undo()
+	}
+	b.termination = termination
+	return legal
+}
+
+// Returns whether the king of the given side is in check in atomic chess.
+// A king next to the enemy king is never in check, and neither is a missing king.
+func (b *Board) atomicKingInCheck(white bool) bool {
+	ourKings, oppKings := b.White.Kings, b.Black.Kings
+	if !white {
+		ourKings, oppKings = oppKings, ourKings
+	}
+	if ourKings == 0 || oppKings == 0 {
+		return false
+	}
+	kingLocation := uint8(bits.TrailingZeros64(ourKings))
+	if kingMasks[kingLocation]&oppKings != 0 {
+		return false
+	}
+	return b.UnderDirectAttack(white, kingLocation)
+}
+
+// Removes the pieces exploded by a capture on the given square: the capturing piece
+// and the non-pawn pieces around it, of both colors. Updates the hashes and strips
+// the castling rights of exploded kings and rooks.
+// Returns the exploded squares, and their pieces packed 4 bits per square in square
+// order as in the mailbox.
+func (b *Board) explode(square uint8) (squares uint64, pieces uint64) {
+	allPieces := b.White.All | b.Black.All
+	squares = (kingMasks[square]&^(b.White.Pawns|b.Black.Pawns) | uint64(1)<<square) & allPieces
+	shift := 0
+	for remaining := squares; remaining != 0; remaining &= remaining - 1 {
+		sq := uint8(bits.TrailingZeros64(remaining))
+		squareBitboard := uint64(1) << sq
+		code := b.mailbox[sq]
+		piece := Piece(code & 7)
+		bitboards, zobristIndex := &(b.White), 0
+		if code&blackPieceBit != 0 {
+			bitboards, zobristIndex = &(b.Black), 6
+		}
+		pieceBitboard := bitboards.pieceBitboard(piece)
+		*pieceBitboard &= ^squareBitboard
+		bitboards.All &= ^squareBitboard
+		b.mailbox[sq] = 0
+		b.hash ^= pieceSquareZobristC[zobristIndex+int(piece)-1][sq]
+		b.materialKey ^= materialZobristC[zobristIndex+int(piece)-1][bits.OnesCount64(*pieceBitboard)]
+		if piece == Pawn {
+			b.pawnHash ^= pieceSquareZobristC[zobristIndex][sq]
+		}
+		pieces |= uint64(code) << shift
+		shift += 4
+	}
+
+	// Exploded kings and rooks lose their castling rights
+	for i, rightSquares := range castleRightSquares {
+		if b.castlerights&(1<<i) == 0 || squares&rightSquares == 0 {
+			continue
+		}
+		switch i {
+		case 0:
+			b.flipWhiteQueensideCastle()
+		case 1:
+			b.flipWhiteKingsideCastle()
+		case 2:
+			b.flipBlackQueensideCastle()
+		case 3:
+			b.flipBlackKingsideCastle()
+		}
+	}
+	return
+}
+
+// Puts back the pieces removed by explode. The hashes are restored by Undo.
+func (b *Board) unexplode(squares uint64, pieces uint64) {
+	for ; squares != 0; squares &= squares - 1 {
+		sq := bits.TrailingZeros64(squares)
+		squareBitboard := uint64(1) << sq
+		bitboards := &(b.White)
+		if pieces&blackPieceBit != 0 {
+			bitboards = &(b.Black)
+		}
+		*bitboards.pieceBitboard(Piece(pieces & 7)) |= squareBitboard
+		bitboards.All |= squareBitboard
+		b.mailbox[sq] = uint8(pieces & 15)
+		pieces >>= 4
+	}
+}
@@ -0,0 +1,137 @@
+package dragontoothmg
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestAtomicStartingPosition(t *testing.T) {
+	perftSolutions := map[int]int64{
+		1: 20,
+		2: 400,
+		3: 8902,
+		4: 197326,
+		5: 4864979,
+	}
+	checkVariantPerftResults(Startpos, VariantAtomic, perftSolutions, t)
+}
+
+func TestAtomicMidgames(t *testing.T) {
+	checkVariantPerftResults("rn2kb1r/1pp1p2p/p2q1pp1/3P4/2P3b1/4PN2/PP3PPP/R2QKB1R b KQkq - 0 1",
+		VariantAtomic, map[int]int64{1: 40, 2: 1238, 3: 45237, 4: 1434825}, t)
+	checkVariantPerftResults("rn1qkb1r/p5pp/2p5/3p4/N3P3/5P2/PPP4P/R1BQK3 w Qkq - 0 1",
+		VariantAtomic, map[int]int64{1: 28, 2: 833, 3: 23353, 4: 714499}, t)
+	checkVariantPerftResults("r4b1r/2kb1N2/p2Bpnp1/8/2Pp3p/1P1PPP2/P5PP/R3K2R b KQ - 0 1",
+		VariantAtomic, map[int]int64{1: 4, 2: 148, 3: 4462, 4: 155172}, t)
+}
+
+func TestAtomicExplosion(t *testing.T) {
+	// The queen takes on d5: the bishop and the rook explode, the pawn survives
+	fen := "4k3/8/2b5/3np3/2R5/8/8/3QK3 w - - 0 1"
+	b := ParseFenVariant(fen, VariantAtomic)
+	b.Make(parseMove("d1d5"))
+	if b.ToFen() != "4k3/8/8/4p3/8/8/8/4K3 b - - 0 1" {
+		t.Error("Wrong explosion:", b.ToFen())
+	}
+	if b.Hash() != recomputeBoardHash(&b) || b.MaterialKey() != recomputeMaterialKey(&b) {
+		t.Error("Hash mismatch after explosion")
+	}
+	b.Undo()
+	if b.ToFen() != fen {
+		t.Error("Undo didn't restore the exploded pieces:", b.ToFen())
+	}
+
+	// An exploded rook loses its castling right
+	b = ParseFenVariant("r3k2r/8/8/8/8/8/6n1/R3K2R b KQkq - 0 1", VariantAtomic)
+	b.Make(parseMove("g2e1"))
+	if b.ToFen() != "r3k2r/8/8/8/8/8/8/R6R w kq - 0 2" {
+		t.Error("Wrong castling rights after explosion:", b.ToFen())
+	}
+}
+
+func TestAtomicLegality(t *testing.T) {
+	tests := []struct {
+		fen     string
+		legal   []string
+		illegal []string
+	}{
+		// Kings can't capture
+		{"4k3/8/8/8/8/8/4p3/4K3 w - - 0 1", []string{"e1d2"}, []string{"e1e2"}},
+		// A capture next to our king explodes it
+		{"4k3/8/8/8/8/8/R2n4/4K3 w - - 0 1", []string{"a2a3"}, []string{"a2d2"}},
+		// Exploding the enemy king is legal, even in check
+		{"4k3/3n4/8/8/7q/8/8/3RK3 w - - 0 1", []string{"d1d7", "e1e2"}, []string{"d1d2"}},
+		// Adjacent kings cancel checks
+		{"8/8/8/8/8/8/3k4/r3K3 w - - 0 1", []string{"e1d1", "e1e2"}, []string{"e1f1"}},
+		// The king can castle next to the enemy king
+		{"8/8/8/8/8/8/5k2/4K2R w K - 0 1", []string{"e1g1"}, nil},
+	}
+	for _, test := range tests {
+		b := ParseFenVariant(test.fen, VariantAtomic)
+		moves := b.GenerateLegalMoves()
+		for _, str := range test.legal {
+			if !slices.Contains(moves, parseMove(str)) {
+				t.Error("Missing move", str, "in", test.fen)
+			}
+		}
+		for _, str := range test.illegal {
+			if slices.Contains(moves, parseMove(str)) {
+				t.Error("Illegal move", str, "in", test.fen)
+			}
+		}
+	}
+
+	b := ParseFenVariant("8/8/8/8/8/8/3k4/r3K3 w - - 0 1", VariantAtomic)
+	if b.OurKingInCheck() {
+		t.Error("Adjacent kings should cancel the check")
+	}
+}
+
+func TestAtomicTermination(t *testing.T) {
+	b := ParseFenVariant("4k3/3n4/8/8/7q/8/8/3RK3 w - - 0 1", VariantAtomic)
+	b.Make(parseMove("d1d7"))
+	moves := b.GenerateLegalMoves()
+	if len(moves) != 0 || !b.IsTerminated(len(moves)) || b.Termination() != TerminationKingExploded {
+		t.Error("Expected an exploded king, got", b.Termination())
+	}
+
+	b = ParseFenVariant("8/8/8/3k4/8/8/3K4/8 w - - 0 1", VariantAtomic)
+	if !b.IsInsufficientMaterial() {
+		t.Error("Bare kings should be a draw")
+	}
+	b = ParseFenVariant("8/8/8/3k4/8/8/3K4/6N1 w - - 0 1", VariantAtomic)
+	if b.IsInsufficientMaterial() {
+		t.Error("A knight can still capture the king")
+	}
+}
+
+// Plays random games, checking the incremental hashes and that Undo restores the board
+func TestAtomicRandomGames(t *testing.T) {
+	rng := rand.New(rand.NewSource(32))
+	for game := 0; game < 50; game++ {
+		b := NewBoardVariant(VariantAtomic)
+		fens := []string{b.ToFen()}
+		for ply := 0; ply < 200; ply++ {
+			moves := b.GenerateLegalMoves()
+			if len(moves) == 0 {
+				break
+			}
+			b.Make(moves[rng.Intn(len(moves))])
+			if b.Hash() != recomputeBoardHash(b) || b.MaterialKey() != recomputeMaterialKey(b) ||
+				b.PawnHash() != recomputePawnHash(b) {
+				t.Fatal("Incremental hash mismatch in", b.ToFen())
+			}
+			if !mailboxInSync(b) {
+				t.Fatal("Mailbox out of sync in", b.ToFen())
+			}
+			fens = append(fens, b.ToFen())
+		}
+		for i := len(fens) - 1; i > 0; i-- {
+			if b.ToFen() != fens[i] {
+				t.Fatal("Expected", fens[i], "but got", b.ToFen())
+			}
+			b.Undo()
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package dragontoothmg
+
+// Attack maps: the squares attacked by each side and piece type, and the attackers of
+// a square, for evaluation (king safety, hanging pieces) and static exchange
+// evaluation. Unlike CountAttacks, these cover both colors and all the squares at
+// once. Squares holding friendly pieces count as attacked, since they are defended.
+// Only the standard piece moves are used, whatever the variant.
+
+import "math/bits"
+
+// The squares attacked by each color (indexed by Color) and piece type (indexed by
+// Piece), like Stockfish's attackedBy. The Nothing index holds the squares attacked
+// by any piece of the color.
+type AttackMaps [2][King + 1]uint64
+
+// Returns the attack maps of both colors, for the current occupancy.
+func (b *Board) AttackMaps() AttackMaps {
+	occupied := b.White.All | b.Black.All
+	return AttackMaps{
+		b.White.attacks(true, occupied),
+		b.Black.attacks(false, occupied),
+	}
+}
+
+// Returns all the squares attacked by the pieces of a color.
+func (b *Board) AttacksBy(color Color) uint64 {
+	occupied := b.White.All | b.Black.All
+	if color == White {
+		return b.White.attacks(true, occupied)[Nothing]
+	}
+	return b.Black.attacks(false, occupied)[Nothing]
+}
+
+// Returns the pieces of both colors attacking a square, with the sliders blocked by
+// the given occupancy instead of the board's, for x-ray analysis. The pieces are
+// still taken from the board: mask the result with the occupancy to leave out the
+// pieces removed from it.
+func (b *Board) AttackersTo(sq Square, occupancy uint64) uint64 {
+	square := uint8(sq)
+	squareBitboard := uint64(1) << square
+	whitePawns := (squareBitboard>>7&^onlyFile[0] | squareBitboard>>9&^onlyFile[7]) & b.White.Pawns
+	blackPawns := (squareBitboard<<7&^onlyFile[7] | squareBitboard<<9&^onlyFile[0]) & b.Black.Pawns
+	diagSliders := b.White.Bishops | b.White.Queens | b.Black.Bishops | b.Black.Queens
+	orthoSliders := b.White.Rooks | b.White.Queens | b.Black.Rooks | b.Black.Queens
+	return whitePawns | blackPawns |
+		knightMasks[square]&(b.White.Knights|b.Black.Knights) |
+		kingMasks[square]&(b.White.Kings|b.Black.Kings) |
+		CalculateBishopMoveBitboard(square, occupancy)&diagSliders |
+		CalculateRookMoveBitboard(square, occupancy)&orthoSliders
+}
+
+// Returns the squares attacked by each piece type of one side, and all of them at
+// the Nothing index.
+func (pieces *Bitboards) attacks(white bool, occupied uint64) (maps [King + 1]uint64) {
+	if white {
+		maps[Pawn] = pieces.Pawns<<9&^onlyFile[0] | pieces.Pawns<<7&^onlyFile[7]
+	} else {
+		maps[Pawn] = pieces.Pawns>>7&^onlyFile[0] | pieces.Pawns>>9&^onlyFile[7]
+	}
+	for knights := pieces.Knights; knights != 0; knights &= knights - 1 {
+		maps[Knight] |= knightMasks[bits.TrailingZeros64(knights)]
+	}
+	for bishops := pieces.Bishops; bishops != 0; bishops &= bishops - 1 {
+		maps[Bishop] |= CalculateBishopMoveBitboard(uint8(bits.TrailingZeros64(bishops)), occupied)
+	}
+	for rooks := pieces.Rooks; rooks != 0; rooks &= rooks - 1 {
+		maps[Rook] |= CalculateRookMoveBitboard(uint8(bits.TrailingZeros64(rooks)), occupied)
+	}
+	for queens := pieces.Queens; queens != 0; queens &= queens - 1 {
+		square := uint8(bits.TrailingZeros64(queens))
+		maps[Queen] |= CalculateBishopMoveBitboard(square, occupied) | CalculateRookMoveBitboard(square, occupied)
+	}
+	for kings := pieces.Kings; kings != 0; kings &= kings - 1 {
+		maps[King] |= kingMasks[bits.TrailingZeros64(kings)]
+	}
+	for piece := Pawn; piece <= King; piece++ {
+		maps[Nothing] |= maps[piece]
+	}
+	return
+}
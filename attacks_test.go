@@ -0,0 +1,72 @@
+package dragontoothmg
+
+import (
+	"math/bits"
+	"testing"
+)
+
+// Compares the attack maps and attackers with CountAttacks, on every square of the
+// positions of a perft tree.
+func TestAttackMaps(t *testing.T) {
+	fens := []string{
+		Startpos,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0",
+		"n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1",
+	}
+	var walk func(b *Board, depth int)
+	walk = func(b *Board, depth int) {
+		maps := b.AttackMaps()
+		occupied := b.White.All | b.Black.All
+		for sq := Square(0); sq < 64; sq++ {
+			attackers := b.AttackersTo(sq, occupied)
+			for color, pieces := range [2]*Bitboards{&(b.White), &(b.Black)} {
+				count, _ := b.CountAttacks(color == int(Black), uint8(sq), 64)
+				if bits.OnesCount64(attackers&pieces.All) != count {
+					t.Fatal("Wrong attackers of", sq, "in", b.ToFen())
+				}
+				attacked := b.AttacksBy(Color(color))&(uint64(1)<<sq) != 0
+				if attacked != (count != 0) || maps[color][Nothing]&(uint64(1)<<sq) != 0 != attacked {
+					t.Fatal("Wrong attacks on", sq, "in", b.ToFen())
+				}
+				for piece := Piece(Pawn); piece <= King; piece++ {
+					byPiece := attackers&*pieces.pieceBitboard(piece) != 0
+					if maps[color][piece]&(uint64(1)<<sq) != 0 != byPiece {
+						t.Fatal("Wrong attack map of piece", piece, "on", sq, "in", b.ToFen())
+					}
+				}
+			}
+		}
+		if depth <= 1 {
+			return
+		}
+		for _, m := range b.GenerateLegalMoves() {
+			b.Make(m)
+			walk(b, depth-1)
+			b.Undo()
+		}
+	}
+	for _, fen := range fens {
+		b := ParseFen(fen)
+		walk(&b, 2)
+	}
+}
+
+func TestAttackersToXray(t *testing.T) {
+	// doubled rooks on the a-file, and a bishop behind a queen on the diagonal
+	b := ParseFen("k7/8/8/8/8/8/R1Q5/RB5K w - - 0 1")
+	occupied := b.White.All | b.Black.All
+	a2, a1, b1 := uint64(1)<<8, uint64(1)<<0, uint64(1)<<1
+	if attackers := b.AttackersTo(Square(56), occupied); attackers&(a1|a2) != a2 {
+		t.Error("Wrong attackers of a8", attackers)
+	}
+	if attackers := b.AttackersTo(Square(56), occupied&^a2) & (occupied &^ a2); attackers&(a1|a2) != a1 {
+		t.Error("Rook behind the removed rook doesn't attack a8", attackers)
+	}
+	queen := uint64(1) << 10
+	if b.AttackersTo(Square(46), occupied&^queen)&b1 == 0 {
+		t.Error("Bishop behind the removed queen doesn't attack g6")
+	}
+	if b.AttacksBy(Black) != kingMasks[56] {
+		t.Error("Wrong attacks of the lone black king")
+	}
+}
@@ -28,11 +28,23 @@ func main() {
 	}
 
 	fmt.Println("\nSABERTOOTHMG MOVE GENERATOR BENCHMARKS")
-	printResultLine(testing.Benchmark(benchmarkStartpos5), "Start position", startposResult5, 5)
-	printResultLine(testing.Benchmark(benchmarkStartpos6), "Start position", startposResult6, 6)
-	printResultLine(testing.Benchmark(benchmarkKiwipete), "Kiwipete position", kpResult, 5)
-	printResultLine(testing.Benchmark(benchmarkDense), "Dense position", denseResult, 6)
-	printResultLine(testing.Benchmark(benchmarkEndgameRP), "Endgame R/P position", endgameResult, 7)
+	// Run with the magic slider lookups, then with PEXT if the CPU supports it
+	for _, pext := range []bool{false, true} {
+		if dragontoothmg.UsePEXT(pext) != pext {
+			fmt.Println("\nPEXT slider lookups are not supported on this CPU")
+			break
+		}
+		if pext {
+			fmt.Println("\nPEXT slider lookups:")
+		} else {
+			fmt.Println("\nMagic slider lookups:")
+		}
+		printResultLine(testing.Benchmark(benchmarkStartpos5), "Start position", startposResult5, 5)
+		printResultLine(testing.Benchmark(benchmarkStartpos6), "Start position", startposResult6, 6)
+		printResultLine(testing.Benchmark(benchmarkKiwipete), "Kiwipete position", kpResult, 5)
+		printResultLine(testing.Benchmark(benchmarkDense), "Dense position", denseResult, 6)
+		printResultLine(testing.Benchmark(benchmarkEndgameRP), "Endgame R/P position", endgameResult, 7)
+	}
 	fmt.Println()
 }
 
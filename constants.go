@@ -5,16 +5,10 @@ import (
 	"math/rand"
 )
 
-// Initialize the magic lookups tables
+// The magic lookup tables are generated into magic_tables.go, with:
+//go:generate go test -run TestMagicTables -update-magic-tables
+
 func init() {
-	magicMovesRook = make([][]uint64, 64)
-	magicMovesBishop = make([][]uint64, 64)
-	for i := 0; i < 64; i++ {
-		magicMovesRook[i] = make([]uint64, magicDbSizeRook[i])
-		magicMovesBishop[i] = make([]uint64, magicDbSizeBishop[i])
-	}
-	generateRookMagicTable()
-	generateBishopMagicTable()
 	generateZobristConstants()
 }
 
@@ -28,46 +22,21 @@ func generateZobristConstants() {
 	for i := 0; i < 4; i++ {
 		castleRightsZobristC[i] = rand.Uint64()
 	}
-}
-
-func generateRookMagicTable() {
-	// For a rook at every board position
-	for i := 0; i < 64; i++ {
-		blockerMask := magicRookBlockerMasks[i]
-		generateBlockerPermutations(Square(i), blockerMask, 0, true)
-	}
-}
-
-func generateBishopMagicTable() {
-	// For a bishop at every board position
-	for i := 0; i < 64; i++ {
-		blockerMask := magicBishopBlockerMasks[i]
-		generateBlockerPermutations(Square(i), blockerMask, 0, false)
+	for i := 0; i < 12; i++ {
+		for j := 0; j < 64; j++ {
+			materialZobristC[i][j] = rand.Uint64()
+		}
 	}
-}
-
-// Recursively generate all permutations of active and inactive bits in the
-// blocker mask. Origin is the piece's starting square. BlockerMaskProgress is
-// the original blocker bitboard, from which we eliminate bits.
-// CurrPerm is the bitboard permutation we are accumulating.
-func generateBlockerPermutations(origin Square, blockerMaskProgress uint64, currPerm uint64, rook bool) {
-	if blockerMaskProgress == 0 {
-		// currPerm represents one possible occupancy pattern on the blocker bitboard
-		if rook {
-			dbindex := (currPerm * magicNumberRook[origin]) >> magicRookShifts[origin]
-			magicMovesRook[origin][dbindex] = rookMovesFromBlockers(origin, currPerm)
-		} else {
-			dbindex := (currPerm * magicNumberBishop[origin]) >> magicBishopShifts[origin]
-			magicMovesBishop[origin][dbindex] = bishopMovesFromBlockers(origin, currPerm)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 4; j++ {
+			checksZobristC[i][j] = rand.Uint64()
+		}
+		for p := Pawn; p <= Queen; p++ {
+			for j := 0; j < 64; j++ {
+				pocketZobristC[i][p][j] = rand.Uint64()
+			}
 		}
-		return
 	}
-	nextBit := bits.TrailingZeros64(blockerMaskProgress)
-	blockerMaskProgress &= blockerMaskProgress - 1
-	without := currPerm
-	with := currPerm | (uint64(1) << uint8(nextBit))
-	generateBlockerPermutations(origin, blockerMaskProgress, without, rook)
-	generateBlockerPermutations(origin, blockerMaskProgress, with, rook)
 }
 
 func rookMovesFromBlockers(origin Square, blockers uint64) uint64 {
@@ -173,11 +142,37 @@ func bishopMovesFromBlockers(origin Square, blockers uint64) uint64 {
 // The starting position FEN
 const Startpos = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
 
+// The crazyhouse starting position FEN, with empty pockets
+const StartposCrazyhouse = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[] w KQkq - 0 1"
+
+// The antichess starting position FEN, without castling rights
+const StartposAntichess = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w - - 0 1"
+
+// The three-check starting position FEN, with three checks left for each side
+const StartposThreeCheck = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3+3 0 1"
+
+// The racing kings starting position FEN
+const StartposRacingKings = "8/8/8/8/8/8/krbnNBRK/qrbnNBRQ w - - 0 1"
+
+// The horde starting position FEN, with 36 white pawns and no white king
+const StartposHorde = "rnbqkbnr/pppppppp/8/1PP2PP1/PPPPPPPP/PPPPPPPP/PPPPPPPP/PPPPPPPP w kq - 0 1"
+
 // Zobrist Constants
 var pieceSquareZobristC [12][64]uint64
 var castleRightsZobristC [4]uint64
 var whiteToMoveZobristC uint64 // active if white is to move
 
+// Material key constants, indexed by colored piece (same order as pieceSquareZobristC)
+// and by the ordinal of the piece (0 for the first knight, 1 for the second, ...).
+var materialZobristC [12][64]uint64
+
+// Crazyhouse pocket constants, indexed by color (white first), piece and the ordinal
+// of the piece in the pocket, like the material key constants.
+var pocketZobristC [2][King][64]uint64
+
+// Three-check constants, indexed by color (white first) and the checks left to give.
+var checksZobristC [2][4]uint64
+
 const kDefaultMoveListLength int = 35 // Average branching factor in chess is about 35
 
 // Bitboard where every bit is active
@@ -344,7 +339,3 @@ var magicDbSizeBishop = [64]uint64{
 	32, 32, 128, 128, 128, 128, 32, 32, 32, 32, 128, 512, 512, 128, 32, 32,
 	32, 32, 128, 512, 512, 128, 32, 32, 32, 32, 128, 128, 128, 128, 32, 32,
 	32, 32, 32, 32, 32, 32, 32, 32, 64, 32, 32, 32, 32, 32, 32, 64}
-
-// The actual magic moves database, populated by init
-var magicMovesRook [][]uint64
-var magicMovesBishop [][]uint64
@@ -0,0 +1,269 @@
+package dragontoothmg
+
+// Crazyhouse support: pockets, promoted-piece tracking and drop moves.
+// In crazyhouse a captured piece changes color and goes to the pocket of the
+// capturing side, from which it can later be dropped onto any empty square
+// instead of making an ordinary move. Promoted pieces return to the pocket as pawns.
+
+import (
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// The crazyhouse rules.
+type crazyhouseRules struct{ standardRules }
+
+func (crazyhouseRules) Variant() Variant { return VariantCrazyhouse }
+func (crazyhouseRules) Startpos() string { return StartposCrazyhouse }
+
+// Generates the standard moves, and the drops that don't leave the king in check.
+func (crazyhouseRules) GenerateMoves(b *Board, piece Piece) []Move {
+	moves := make([]Move, 0, b.getMoveListLength(piece))
+	allowDest := b.standardMoves(&moves, piece)
+	b.dropMoves(&moves, piece, allowDest)
+	return moves
+}
+
+func (crazyhouseRules) Make(b *Board, h *History, captured Piece) {
+	h.capturedPromoted = b.promoted&(uint64(1)<<h.Move.To()) != 0
+	h.pocketed = b.crazyhouseMove(h.Move, captured)
+}
+
+// Takes the captured piece back from the pocket, and moves the promoted piece back.
+func (crazyhouseRules) Undo(b *Board, h *History) {
+	fromBitboard := uint64(1) << h.Move.From()
+	toBitboard := uint64(1) << h.Move.To()
+	if b.promoted&toBitboard != 0 && h.Move.Promote() == Nothing {
+		b.promoted |= fromBitboard
+	}
+	b.promoted &= ^toBitboard
+	if h.capturedPromoted {
+		b.promoted |= toBitboard
+	}
+	if h.pocketed != Nothing {
+		b.pockets[sideIndex(!b.Wtomove)][h.pocketed]--
+	}
+}
+
+// Pieces can always come back from the pockets.
+func (crazyhouseRules) InsufficientMaterial(b *Board) bool { return false }
+
+func (crazyhouseRules) Hash(b *Board) uint64 {
+	var hash uint64
+	for color := range b.pockets {
+		for p := Pawn; p <= Queen; p++ {
+			for j := 0; j < int(b.pockets[color][p]); j++ {
+				hash ^= pocketZobristC[color][p][j]
+			}
+		}
+	}
+	return hash
+}
+
+// Reads the pockets, written in brackets after the piece placement, such as "[Qnp]".
+func (crazyhouseRules) ParseFen(b *Board, fields []string) []string {
+	if placement, pocket, ok := strings.Cut(fields[0], "["); ok {
+		fields[0] = placement
+		for _, c := range strings.TrimSuffix(pocket, "]") {
+			if p := strings.IndexRune(pieceLetters, unicode.ToUpper(c)); p >= Pawn && p <= Queen {
+				b.pockets[sideIndex(unicode.IsUpper(c))][p]++
+			}
+		}
+	}
+	return fields
+}
+
+func (crazyhouseRules) FormatFen(b *Board, fields []string) []string {
+	pockets := "["
+	for _, white := range [2]bool{true, false} {
+		pocket := b.Pocket(white)
+		for p := Piece(Queen); p >= Pawn; p-- {
+			letter := pieceLetters[p]
+			if !white {
+				letter += 'a' - 'A'
+			}
+			pockets += strings.Repeat(string(letter), int(pocket[p]))
+		}
+	}
+	fields[0] += pockets + "]"
+	return fields
+}
+
+// Marks a move as a drop; the dropped piece is stored in the promotion bits.
+const dropFlag Move = 0x8000
+
+// Piece letters indexed by Piece, as used in FEN pockets and drop moves.
+const pieceLetters = " PNBRQK"
+
+// The pieces of one side available for dropping, indexed by Piece (Pawn to Queen).
+type Pocket [King]uint8
+
+// Returns the number of pieces in the pocket.
+func (p Pocket) Count() int {
+	count := 0
+	for _, n := range p {
+		count += int(n)
+	}
+	return count
+}
+
+// Returns the crazyhouse pocket of the given side.
+func (b *Board) Pocket(white bool) Pocket {
+	if white {
+		return b.pockets[0]
+	}
+	return b.pockets[1]
+}
+
+// Returns the bitboard of promoted pieces (of both sides) in crazyhouse.
+// When captured, these pieces go to the pocket as pawns.
+func (b *Board) Promoted() uint64 {
+	return b.promoted
+}
+
+// Index of a side into the per-side arrays of the board and their Zobrist constants.
+func sideIndex(white bool) int {
+	if white {
+		return 0
+	}
+	return 1
+}
+
+// Adds a piece to a pocket, updating the hash.
+func (b *Board) addToPocket(white bool, p Piece) {
+	pocket := &b.pockets[sideIndex(white)]
+	b.hash ^= pocketZobristC[sideIndex(white)][p][pocket[p]]
+	pocket[p]++
+}
+
+// Removes a piece from a pocket, updating the hash.
+func (b *Board) removeFromPocket(white bool, p Piece) {
+	pocket := &b.pockets[sideIndex(white)]
+	pocket[p]--
+	b.hash ^= pocketZobristC[sideIndex(white)][p][pocket[p]]
+}
+
+// Generates drops from the pocket of the side to move. If piece is not Nothing, only
+// that piece is dropped. Only empty squares in allowDest can be dropped on, which
+// covers interpositions when in check; a drop never exposes our own king.
+func (b *Board) dropMoves(moveList *[]Move, piece Piece, allowDest uint64) {
+	pocket := &b.pockets[sideIndex(b.Wtomove)]
+	empty := ^(b.White.All | b.Black.All) & allowDest
+	for p := Piece(Pawn); p <= Queen; p++ {
+		if pocket[p] == 0 || (piece != Nothing && piece != p) {
+			continue
+		}
+		targets := empty
+		if p == Pawn { // pawns can't be dropped on the first or last rank
+			targets &= ^(onlyRank[0] | onlyRank[7])
+		}
+		for targets != 0 {
+			target := bits.TrailingZeros64(targets)
+			targets &= targets - 1
+			var move Move
+			move.Setto(Square(target)).Setdrop(p)
+			*moveList = append(*moveList, move)
+		}
+	}
+}
+
+// Updates the crazyhouse state for an ordinary move, after the side to move is flipped.
+// The captured piece (Nothing if none) goes to the pocket of the mover, demoted to a
+// pawn if it was promoted. Returns the piece put into the pocket.
+func (b *Board) crazyhouseMove(m Move, captured Piece) Piece {
+	fromBitboard := uint64(1) << m.From()
+	toBitboard := uint64(1) << m.To()
+	pocketed := captured
+	if captured != Nothing && b.promoted&toBitboard != 0 {
+		pocketed = Pawn
+	}
+	b.promoted &= ^toBitboard
+	if b.promoted&fromBitboard != 0 || m.Promote() != Nothing {
+		b.promoted = b.promoted&^fromBitboard | toBitboard
+	}
+	if pocketed != Nothing {
+		b.addToPocket(!b.Wtomove, pocketed)
+	}
+	return pocketed
+}
+
+// Makes a drop move. Dropping a pawn resets the halfmove clock, like a pawn move.
+func (b *Board) makeDrop(m Move) {
+	h := History{
+		Move:               m,
+		hashBefore:         b.hash,
+		pawnHashBefore:     b.pawnHash,
+		materialKeyBefore:  b.materialKey,
+		oldCastleRights:    b.castlerights,
+		oldEpCaptureSquare: b.enpassant,
+		oldHalfmoveClock:   b.Halfmoveclock,
+	}
+	var ourBitboardPtr *Bitboards
+	var ourPiecesPawnZobristIndex int
+	var ourColorBit uint8
+	if b.Wtomove {
+		ourBitboardPtr = &(b.White)
+		ourPiecesPawnZobristIndex = 0
+	} else {
+		ourBitboardPtr = &(b.Black)
+		ourPiecesPawnZobristIndex = 6
+		ourColorBit = blackPieceBit
+		b.Fullmoveno++ // increment after black's move
+	}
+	piece := m.Dropped()
+	toBitboard := uint64(1) << m.To()
+
+	if piece == Pawn {
+		b.Halfmoveclock = 0
+	} else {
+		b.Halfmoveclock++
+	}
+
+	// Take the piece from the pocket and put it on the board
+	b.removeFromPocket(b.Wtomove, piece)
+	pieceBitboard := ourBitboardPtr.pieceBitboard(piece)
+	*pieceBitboard |= toBitboard
+	ourBitboardPtr.All |= toBitboard
+	b.mailbox[m.To()] = uint8(piece) | ourColorBit
+	b.hash ^= pieceSquareZobristC[ourPiecesPawnZobristIndex+(int(piece)-1)][m.To()]
+	b.materialKey ^= materialZobristC[ourPiecesPawnZobristIndex+(int(piece)-1)][bits.OnesCount64(*pieceBitboard)-1]
+	if piece == Pawn {
+		b.pawnHash ^= pieceSquareZobristC[ourPiecesPawnZobristIndex][m.To()]
+	}
+
+	// Flip the side to move and clear the en passant square
+	b.hash ^= whiteToMoveZobristC
+	b.Wtomove = !b.Wtomove
+	b.hash ^= uint64(b.enpassant)
+	b.enpassant = 0
+
+	h.hashCurrent = b.hash
+	b.History = append(b.History, h)
+}
+
+// Undoes a drop move, given its history entry.
+func (b *Board) undoDrop(u *History) {
+	b.Wtomove = !b.Wtomove
+	var ourBitboardPtr *Bitboards
+	if b.Wtomove {
+		ourBitboardPtr = &(b.White)
+	} else {
+		ourBitboardPtr = &(b.Black)
+		b.Fullmoveno-- // decrement after undoing black's move
+	}
+	b.Halfmoveclock = u.oldHalfmoveClock
+
+	toBitboard := uint64(1) << u.Move.To()
+	piece := u.Move.Dropped()
+	*ourBitboardPtr.pieceBitboard(piece) &= ^toBitboard
+	ourBitboardPtr.All &= ^toBitboard
+	b.mailbox[u.Move.To()] = 0
+	b.pockets[sideIndex(b.Wtomove)][piece]++
+
+	b.enpassant = u.oldEpCaptureSquare
+	b.hash = u.hashBefore
+	b.pawnHash = u.pawnHashBefore
+	b.materialKey = u.materialKeyBefore
+	b.History = b.History[:len(b.History)-1]
+}
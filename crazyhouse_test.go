@@ -0,0 +1,186 @@
+package dragontoothmg
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestCrazyhouseStartingPosition(t *testing.T) {
+	perftSolutions := map[int]int64{
+		1: 20,
+		2: 400,
+		3: 8902,
+		4: 197281,
+		5: 4888832,
+	}
+	checkPerftResults(StartposCrazyhouse, perftSolutions, t)
+}
+
+func TestCrazyhouseFullPockets(t *testing.T) {
+	perftSolutions := map[int]int64{
+		1: 301,
+		2: 75353,
+	}
+	checkPerftResults("2k5/8/8/8/8/8/8/4K3[QRBNPqrbnp] w - - 0 1", perftSolutions, t)
+}
+
+func TestCrazyhouseFen(t *testing.T) {
+	fens := []string{
+		StartposCrazyhouse,
+		"r1bqk2r/pppp1ppp/2n1p3/4P3/1b1Pn3/2NB1N2/PPP2PPP/R1BQK2R[] b KQkq - 0 1",
+		"2k5/8/8/8/8/8/8/4K3[QRBNPqrbnp] w - - 0 1",
+		"r1bQ~kb1r/ppp2ppp/2n5/8/8/8/PPP2PPP/RNB1KBNR[Pnp] b KQkq - 0 7",
+	}
+	for _, fen := range fens {
+		b := ParseFen(fen)
+		if b.Variant() != VariantCrazyhouse {
+			t.Error("Bracket pocket didn't select crazyhouse for", fen)
+		}
+		if b.ToFen() != fen {
+			t.Error("Fen round trip failed:\n", fen, "\n", b.ToFen())
+		}
+	}
+
+	b := ParseFen("r1bQ~kb1r/ppp2ppp/2n5/8/8/8/PPP2PPP/RNB1KBNR[PPnp] b KQkq - 0 7")
+	if white := b.Pocket(true); white[Pawn] != 2 || white.Count() != 2 {
+		t.Error("Wrong white pocket", white)
+	}
+	if black := b.Pocket(false); black[Knight] != 1 || black[Pawn] != 1 || black.Count() != 2 {
+		t.Error("Wrong black pocket", black)
+	}
+	if b.Promoted() != uint64(1)<<59 {
+		t.Error("Wrong promoted pieces", b.Promoted())
+	}
+	other := ParseFen("r1bQ~kb1r/ppp2ppp/2n5/8/8/8/PPP2PPP/RNB1KBNR[Pnp] b KQkq - 0 7")
+	if b.Hash() == other.Hash() {
+		t.Error("Pockets are not part of the hash")
+	}
+}
+
+func TestCrazyhouseDropParsing(t *testing.T) {
+	for _, str := range []string{"P@e4", "N@f3", "Q@a8"} {
+		m, err := ParseMove(str)
+		if err != nil || !m.IsDrop() || m.Promote() != Nothing || m.String() != str {
+			t.Error("Drop parsing failed for", str, m.String(), err)
+		}
+	}
+	for _, str := range []string{"K@e4", "x@e4", "N@i9"} {
+		if _, err := ParseMove(str); err == nil {
+			t.Error("Expected an error parsing", str)
+		}
+	}
+
+	b := ParseFen("4k3/8/8/8/8/8/8/4K3[N] w - - 0 1")
+	m, err := ShortAlgebraicToMove("N@f3", &b)
+	if err != nil || m != parseMove("N@f3") {
+		t.Error("Short algebraic drop failed", m.String(), err)
+	}
+	if _, err := ShortAlgebraicToMove("@e4", &b); err == nil {
+		t.Error("Dropped a pawn that isn't in the pocket")
+	}
+}
+
+func TestCrazyhouseDrops(t *testing.T) {
+	tests := []struct {
+		fen      string
+		expected []string
+		missing  []string
+	}{
+		// Interpose a drop against a rook check
+		{"4k3/8/8/8/8/8/8/r3K3[N] w - - 0 1",
+			[]string{"N@b1", "N@c1", "N@d1"}, []string{"N@e4", "N@a2"}},
+		// No drops against a knight check
+		{"4k3/8/8/8/8/3n4/8/4K3[Q] w - - 0 1",
+			nil, []string{"Q@e2", "Q@d2"}},
+		// Pawns can't be dropped on the back ranks
+		{"4k3/8/8/8/8/8/8/4K3[p] b - - 0 1",
+			[]string{"P@a2", "P@h7"}, []string{"P@a1", "P@h8"}},
+	}
+	for _, test := range tests {
+		b := ParseFen(test.fen)
+		moves := b.GenerateLegalMoves()
+		for _, str := range test.expected {
+			if !slices.Contains(moves, parseMove(str)) {
+				t.Error("Missing drop", str, "in", test.fen)
+			}
+		}
+		for _, str := range test.missing {
+			if slices.Contains(moves, parseMove(str)) {
+				t.Error("Illegal drop", str, "in", test.fen)
+			}
+		}
+	}
+
+	// Knight drops only
+	b := ParseFen("4k3/8/8/8/8/8/8/4K3[QNp] w - - 0 1")
+	for _, m := range b.GenerateMovesForPiece(Knight) {
+		if m.Dropped() != Knight {
+			t.Error("Unexpected move for knights", m.String())
+		}
+	}
+}
+
+func TestCrazyhouseCaptures(t *testing.T) {
+	// A captured promoted queen goes to the pocket as a pawn
+	b := ParseFen("r1bQ~kb1r/ppp2ppp/2n5/8/8/8/PPP2PPP/RNB1KBNR[N] b KQkq - 0 7")
+	b.Make(parseMove("c6d8"))
+	if pocket := b.Pocket(false); pocket[Pawn] != 1 || pocket.Count() != 1 || b.Promoted() != 0 {
+		t.Error("Promoted piece not demoted on capture:", b.ToFen())
+	}
+	if b.Hash() != recomputeBoardHash(&b) {
+		t.Error("Hash mismatch after capture")
+	}
+	b.Make(parseMove("N@e5"))
+	b.Make(parseMove("P@e6"))
+	expected := "r1bnkb1r/ppp2ppp/4p3/4N3/8/8/PPP2PPP/RNB1KBNR[] w KQkq - 0 9"
+	if b.ToFen() != expected {
+		t.Error("Expected\n", expected, "\nbut got\n", b.ToFen())
+	}
+	b.Undo()
+	b.Undo()
+	b.Undo()
+	if b.ToFen() != "r1bQ~kb1r/ppp2ppp/2n5/8/8/8/PPP2PPP/RNB1KBNR[N] b KQkq - 0 7" {
+		t.Error("Undo failed:", b.ToFen())
+	}
+
+	// An en passant capture pockets a pawn
+	b = ParseFen("4k3/8/8/3Pp3/8/8/8/4K3[] w - e6 0 1")
+	b.Make(parseMove("d5e6"))
+	if b.Pocket(true)[Pawn] != 1 {
+		t.Error("En passant capture didn't pocket a pawn:", b.ToFen())
+	}
+}
+
+// Plays random games, checking the incremental hashes and that Undo restores the board
+func TestCrazyhouseRandomGames(t *testing.T) {
+	rng := rand.New(rand.NewSource(31))
+	for game := 0; game < 50; game++ {
+		b := NewBoardVariant(VariantCrazyhouse)
+		fens := []string{b.ToFen()}
+		for ply := 0; ply < 200; ply++ {
+			moves := b.GenerateLegalMoves()
+			if len(moves) == 0 {
+				break
+			}
+			b.Make(moves[rng.Intn(len(moves))])
+			if b.Hash() != recomputeBoardHash(b) || b.MaterialKey() != recomputeMaterialKey(b) ||
+				b.PawnHash() != recomputePawnHash(b) {
+				t.Fatal("Incremental hash mismatch in", b.ToFen())
+			}
+			if parsed := ParseFen(b.ToFen()); parsed.Hash() != b.Hash() {
+				t.Fatal("Fen round trip changed the hash of", b.ToFen())
+			}
+			if !mailboxInSync(b) {
+				t.Fatal("Mailbox out of sync in", b.ToFen())
+			}
+			fens = append(fens, b.ToFen())
+		}
+		for i := len(fens) - 1; i > 0; i-- {
+			if b.ToFen() != fens[i] {
+				t.Fatal("Expected", fens[i], "but got", b.ToFen())
+			}
+			b.Undo()
+		}
+	}
+}
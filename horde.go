@@ -0,0 +1,63 @@
+package dragontoothmg
+
+// Horde support. White starts with 36 pawns and no king, and wins by
+// checkmating the black king, while black wins by capturing all the white
+// pieces. White pawns on the first rank may advance two squares, like the
+// pawns on the second rank.
+
+import "math/bits"
+
+// The horde rules.
+type hordeRules struct{ standardRules }
+
+func (hordeRules) Variant() Variant { return VariantHorde }
+func (hordeRules) Startpos() string { return StartposHorde }
+
+func (hordeRules) GenerateMoves(b *Board, piece Piece) []Move { return b.hordeMoves(piece) }
+
+// White has no king.
+func (hordeRules) InCheck(b *Board) bool {
+	return !b.Wtomove && b.standardKingInCheck()
+}
+
+func (hordeRules) Termination(b *Board, moveCount int) Termination {
+	if b.White.All == 0 {
+		return TerminationHordeDestroyed
+	}
+	return TerminationNone
+}
+
+// The rules of insufficient material assume that both sides have a king.
+func (hordeRules) InsufficientMaterial(b *Board) bool { return false }
+
+// Generates the horde moves. If piece is not Nothing, only moves of that piece are generated.
+// Without a king, white moves can't be illegal, so the king safety logic is skipped.
+func (b *Board) hordeMoves(piece Piece) []Move {
+	if b.White.All == 0 { // the game is over
+		return []Move{}
+	}
+	moves := make([]Move, 0, b.getMoveListLength(piece))
+	if !b.Wtomove || b.White.Kings != 0 {
+		b.standardMoves(&moves, piece)
+		return moves
+	}
+	b.pseudoLegalMoves(&moves, piece)
+	if piece == Nothing || piece == Pawn {
+		b.firstRankDoublePushes(&moves)
+	}
+	return moves
+}
+
+// Generates the double pushes of the white pawns on the first rank.
+func (b *Board) firstRankDoublePushes(moveList *[]Move) {
+	free := ^(b.White.All | b.Black.All)
+	targets := (b.White.Pawns & onlyRank[0]) << 8 & free
+	targets = targets << 8 & free
+	for targets != 0 {
+		target := bits.TrailingZeros64(targets)
+		targets &= targets - 1
+		var move Move
+		move.Setfrom(Square(target - 16)).Setto(Square(target))
+		*moveList = append(*moveList, move)
+	}
+}
@@ -0,0 +1,81 @@
+package dragontoothmg
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestHordeStartingPosition(t *testing.T) {
+	perftSolutions := map[int]int64{
+		1: 8,
+		2: 128,
+		3: 1274,
+		4: 23310,
+		5: 265223,
+	}
+	checkVariantPerftResults(StartposHorde, VariantHorde, perftSolutions, t)
+}
+
+func TestHordeMidgames(t *testing.T) {
+	checkVariantPerftResults("4k3/pp4q1/3P2p1/8/P3PP2/PPP2r2/PPP5/PPPP4 b - - 0 1",
+		VariantHorde, map[int]int64{1: 30, 2: 241, 3: 6633, 4: 56539}, t)
+	// c1c3 and f1f3 don't allow en passant captures
+	checkVariantPerftResults("k7/5p2/4p2P/3p2P1/2p2P2/1p2P2P/p2P2P1/2P2P2 w - - 0 1",
+		VariantHorde, map[int]int64{1: 13, 2: 172, 3: 2205, 4: 33781}, t)
+}
+
+func TestHordeFirstRankPushes(t *testing.T) {
+	b := ParseFenVariant("4k3/8/8/8/8/8/1P6/PP6 w - - 0 1", VariantHorde)
+	moves := b.GenerateLegalMoves()
+	for _, str := range []string{"a1a2", "a1a3", "b2b3", "b2b4"} {
+		if !slices.Contains(moves, parseMove(str)) {
+			t.Error("Missing move", str)
+		}
+	}
+	if slices.Contains(moves, parseMove("b1b3")) {
+		t.Error("Pawn jumped over a pawn")
+	}
+	if pawnMoves := b.GenerateMovesForPiece(Pawn); len(pawnMoves) != len(moves) {
+		t.Error("Expected only pawn moves, got", pawnMoves)
+	}
+}
+
+// Only double pushes from the second rank allow en passant.
+func TestHordeFirstRankPushNoEnPassant(t *testing.T) {
+	b := ParseFenVariant("4k3/8/8/8/8/1p6/8/P7 w - - 0 1", VariantHorde)
+	b.Make(parseMove("a1a3"))
+	if b.enpassant != 0 || b.ToFen() != "4k3/8/8/8/8/Pp6/8/8 b - - 0 1" {
+		t.Error("First-rank double push set an en passant square:", b.ToFen())
+	}
+	if slices.Contains(b.GenerateLegalMoves(), parseMove("b3a2")) {
+		t.Error("Captured en passant after a first-rank double push")
+	}
+	b.Undo()
+	if mi := b.MoveInfo(parseMove("a1a3")); mi.Kind() != MoveKindQuiet {
+		t.Error("First-rank double push has kind", mi.Kind())
+	}
+}
+
+func TestHordeTermination(t *testing.T) {
+	tests := []struct {
+		fen         string
+		termination Termination
+	}{
+		// all the white pieces were captured
+		{"4k3/8/8/8/8/8/8/8 w - - 0 1", TerminationHordeDestroyed},
+		// the horde is stalemated
+		{"4k3/8/8/8/8/p7/P7/8 w - - 0 1", TerminationStalemate},
+		// the black king is mated
+		{"3QkQ2/8/4P3/8/8/8/8/8 b - - 0 1", TerminationCheckmate},
+		// a lone pawn can still promote
+		{"4k3/8/8/8/8/8/P7/8 w - - 0 1", TerminationNone},
+	}
+	for _, test := range tests {
+		b := ParseFenVariant(test.fen, VariantHorde)
+		moves := b.GenerateLegalMoves()
+		terminated := b.IsTerminated(len(moves))
+		if terminated != (test.termination != TerminationNone) || b.Termination() != test.termination {
+			t.Error("Expected", test.termination, "but got", b.Termination(), "for", test.fen)
+		}
+	}
+}
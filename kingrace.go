@@ -0,0 +1,99 @@
+package dragontoothmg
+
+// King race variants.
+//
+// In king of the hill, a king reaching one of the four center squares wins.
+//
+// In racing kings, both sides start on the first two ranks, and the first king
+// to reach the 8th rank wins. Giving check is illegal, so no king is ever in check.
+// When the white king gets there first, black may still draw by reaching the
+// 8th rank on the very next move.
+
+// The center squares d4, e4, d5 and e5 of king of the hill.
+const hillSquares uint64 = 1<<27 | 1<<28 | 1<<35 | 1<<36
+
+// The king of the hill rules.
+type kingOfTheHillRules struct{ standardRules }
+
+func (kingOfTheHillRules) Variant() Variant { return VariantKingOfTheHill }
+
+func (r kingOfTheHillRules) GenerateMoves(b *Board, piece Piece) []Move {
+	if r.Termination(b, 0) != TerminationNone { // the game is over
+		return []Move{}
+	}
+	return r.standardRules.GenerateMoves(b, piece)
+}
+
+func (kingOfTheHillRules) Termination(b *Board, moveCount int) Termination {
+	if (b.White.Kings|b.Black.Kings)&hillSquares != 0 {
+		return TerminationKingOfTheHill
+	}
+	return TerminationNone
+}
+
+// A lone king can still reach the center.
+func (kingOfTheHillRules) InsufficientMaterial(b *Board) bool { return false }
+
+// The racing kings rules.
+type racingKingsRules struct{ standardRules }
+
+func (racingKingsRules) Variant() Variant { return VariantRacingKings }
+func (racingKingsRules) Startpos() string { return StartposRacingKings }
+
+func (racingKingsRules) GenerateMoves(b *Board, piece Piece) []Move { return b.racingKingsMoves(piece) }
+
+func (racingKingsRules) Termination(b *Board, moveCount int) Termination {
+	return b.racingKingsTermination()
+}
+
+// Lone kings can still race.
+func (racingKingsRules) InsufficientMaterial(b *Board) bool { return false }
+
+// Generates the racing kings moves. If piece is not Nothing, only moves of that piece are generated.
+func (b *Board) racingKingsMoves(piece Piece) []Move {
+	if b.racingKingsTermination() != TerminationNone {
+		return []Move{}
+	}
+	return b.nonCheckingMoves(piece)
+}
+
+// Generates the legal moves that don't give check.
+func (b *Board) nonCheckingMoves(piece Piece) []Move {
+	moves := make([]Move, 0, b.getMoveListLength(piece))
+	b.standardMoves(&moves, piece)
+	// Undo resets the termination, which may have been computed already
+	termination := b.termination
+	legal := moves[:0]
+	for _, move := range moves {
+		b.Make(move)
+		if !b.OurKingInCheck() {
+			legal = append(legal, move)
+		}
+		b.Undo()
+	}
+	b.termination = termination
+	return legal
+}
+
+// Returns the racing kings result, or TerminationNone if the race goes on.
+func (b *Board) racingKingsTermination() Termination {
+	whiteHome := b.White.Kings&onlyRank[7] != 0
+	blackHome := b.Black.Kings&onlyRank[7] != 0
+	switch {
+	case whiteHome && blackHome:
+		return TerminationRacingKingsDraw
+	case blackHome:
+		return TerminationRacingKings
+	case !whiteHome:
+		return TerminationNone
+	case b.Wtomove: // black didn't equalize
+		return TerminationRacingKings
+	}
+	// Black can still draw by reaching the 8th rank as well
+	for _, move := range b.nonCheckingMoves(King) {
+		if (uint64(1)<<move.To())&onlyRank[7] != 0 {
+			return TerminationNone
+		}
+	}
+	return TerminationRacingKings
+}
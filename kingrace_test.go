@@ -0,0 +1,108 @@
+package dragontoothmg
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestKingOfTheHillStartingPosition(t *testing.T) {
+	perftSolutions := map[int]int64{
+		1: 20,
+		2: 400,
+		3: 8902,
+		4: 197281,
+	}
+	checkVariantPerftResults(Startpos, VariantKingOfTheHill, perftSolutions, t)
+}
+
+// Every king move to the center ends the game
+func TestKingOfTheHillRace(t *testing.T) {
+	fen := "4k3/8/8/8/8/4K3/8/8 w - - 0 1"
+	b := ParseFenVariant(fen, VariantKingOfTheHill)
+	standard := ParseFen(fen)
+	var expected int64
+	for _, move := range standard.GenerateLegalMoves() {
+		if (uint64(1)<<move.To())&hillSquares == 0 {
+			standard.Make(move)
+			expected += Perft(&standard, 2)
+			standard.Undo()
+		}
+	}
+	if result := Perft(&b, 3); result != expected {
+		t.Error("Expected", expected, "but got", result)
+	}
+
+	b.Make(parseMove("e3d4"))
+	moves := b.GenerateLegalMoves()
+	if len(moves) != 0 || !b.IsTerminated(len(moves)) || b.Termination() != TerminationKingOfTheHill {
+		t.Error("Expected a king of the hill win, got", b.Termination())
+	}
+
+	b = ParseFenVariant("8/8/8/3k4/8/8/8/4K3 w - - 0 1", VariantKingOfTheHill)
+	if moves := b.GenerateLegalMoves(); !b.IsTerminated(len(moves)) || b.Termination() != TerminationKingOfTheHill {
+		t.Error("Expected a king of the hill win, got", b.Termination())
+	}
+}
+
+func TestRacingKingsStartingPosition(t *testing.T) {
+	perftSolutions := map[int]int64{
+		1: 21,
+		2: 421,
+		3: 11264,
+		4: 296242,
+		5: 9472927,
+	}
+	checkVariantPerftResults(StartposRacingKings, VariantRacingKings, perftSolutions, t)
+}
+
+func TestRacingKingsChecks(t *testing.T) {
+	b := ParseFenVariant("8/8/8/8/8/k7/8/1R4K1 w - - 0 1", VariantRacingKings)
+	moves := b.GenerateLegalMoves()
+	for _, str := range []string{"b1a1", "b1b3"} {
+		if slices.Contains(moves, parseMove(str)) {
+			t.Error("Checking move", str, "should be illegal")
+		}
+	}
+	for _, str := range []string{"b1b2", "g1g2"} {
+		if !slices.Contains(moves, parseMove(str)) {
+			t.Error("Missing move", str)
+		}
+	}
+	if rookMoves := b.GenerateMovesForPiece(Rook); slices.Contains(rookMoves, parseMove("b1a1")) {
+		t.Error("Checking rook move generated")
+	}
+}
+
+func TestRacingKingsTermination(t *testing.T) {
+	tests := []struct {
+		fen         string
+		termination Termination
+	}{
+		// black can't reach the 8th rank in time
+		{"K7/8/8/8/8/8/7k/8 b - - 0 1", TerminationRacingKings},
+		// black can still equalize
+		{"K7/7k/8/8/8/8/8/8 b - - 0 1", TerminationNone},
+		// black didn't equalize
+		{"K7/8/7k/8/8/8/8/8 w - - 0 1", TerminationRacingKings},
+		// black got there first
+		{"7k/8/8/8/8/8/8/K7 w - - 0 1", TerminationRacingKings},
+		{"K6k/8/8/8/8/8/8/8 w - - 0 1", TerminationRacingKingsDraw},
+	}
+	for _, test := range tests {
+		b := ParseFenVariant(test.fen, VariantRacingKings)
+		moves := b.GenerateLegalMoves()
+		terminated := b.IsTerminated(len(moves))
+		if terminated != (test.termination != TerminationNone) || b.Termination() != test.termination {
+			t.Error("Expected", test.termination, "but got", b.Termination(), "for", test.fen)
+		}
+		if terminated && len(moves) != 0 {
+			t.Error("Moves generated after the end of the race", test.fen)
+		}
+	}
+
+	b := ParseFenVariant("K7/7k/8/8/8/8/8/8 b - - 0 1", VariantRacingKings)
+	b.Make(parseMove("h7g8"))
+	if moves := b.GenerateLegalMoves(); !b.IsTerminated(len(moves)) || b.Termination() != TerminationRacingKingsDraw {
+		t.Error("Expected a draw, got", b.Termination())
+	}
+}
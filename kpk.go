@@ -0,0 +1,50 @@
+package dragontoothmg
+
+import "math/bits"
+
+// King and pawn versus king bitbase, generated into kpk_bitbase.go (kpkBitbase).
+// Positions are indexed by the side to move, the squares of the kings, and
+// the square of the pawn, which is white and on the files a-d (24 squares).
+// A set bit means a win for the side with the pawn.
+
+//go:generate go test -run TestKPKBitbase -update-kpk-bitbase
+
+const kpkSize = 2 * 64 * 64 * 24
+
+func kpkIndex(wtm bool, wksq, bksq, psq uint8) int {
+	idx := (int(psq>>3-1)*4 + int(psq&7)) * 2
+	if !wtm {
+		idx++
+	}
+	return idx<<12 | int(wksq)<<6 | int(bksq)
+}
+
+// Probes a king and pawn versus king position, with the pawn of either color.
+// Win reports whether the side with the pawn wins with best play (the 50-move
+// rule aside). Ok is false if the position has other material, a side has no
+// king or more than one, or the pawn is on the first or last rank.
+func ProbeKPK(b *Board) (win bool, ok bool) {
+	strong, weak := &b.White, &b.Black
+	wtm, flip := b.Wtomove, uint8(0)
+	if b.Black.Pawns != 0 {
+		strong, weak = weak, strong
+		wtm, flip = !wtm, 56
+	}
+	if bits.OnesCount64(strong.Pawns) != 1 || strong.All != strong.Kings|strong.Pawns ||
+		weak.All != weak.Kings {
+		return false, false
+	}
+	// Each side needs its king, and the pawn can't be on the first or last rank
+	if bits.OnesCount64(strong.Kings) != 1 || bits.OnesCount64(weak.Kings) != 1 ||
+		strong.Pawns&(onlyRank[0]|onlyRank[7]) != 0 {
+		return false, false
+	}
+	wksq := uint8(bits.TrailingZeros64(strong.Kings)) ^ flip
+	bksq := uint8(bits.TrailingZeros64(weak.Kings)) ^ flip
+	psq := uint8(bits.TrailingZeros64(strong.Pawns)) ^ flip
+	if psq&7 > 3 {
+		wksq, bksq, psq = wksq^7, bksq^7, psq^7
+	}
+	idx := kpkIndex(wtm, wksq, bksq, psq)
+	return kpkBitbase[idx/32]&(1<<(idx%32)) != 0, true
+}
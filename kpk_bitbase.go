@@ -0,0 +1,775 @@
+// Code generated by "go test -run TestKPKBitbase -update-kpk-bitbase"; DO NOT EDIT.
+
+package dragontoothmg
+
+// The king and pawn versus king bitbase, indexed by kpkIndex.
+var kpkBitbase = [6144]uint32{
+	0xc0c0fcfc, 0xc0c0c0c0, 0xc0c0f8f8, 0xc0c0c0c0, 0xc0c0f0f1, 0xc0c0c0c0, 0xc0c0e2e3, 0xc0c0c0c0,
+	0xc0c0c6c7, 0xc0c0c0c0, 0xc0c08e8f, 0xc0c0c0c0, 0xc0c01e1f, 0xc0c0c0c0, 0xc0c03e3f, 0xc0c0c0c0,
+	0x00000000, 0x00000000, 0xc0f8f8f8, 0xc0c0c0c0, 0xc0f0f0f1, 0xc0c0c0c0, 0xc0e0e2e3, 0xc0c0c0c0,
+	0xc0c0c6c7, 0xc0c0c0c0, 0xc0808e8f, 0xc0c0c0c0, 0xc0001e1f, 0xc0c0c0c0, 0xc0003e3f, 0xc0c0c0c0,
+	0xf8f8fcff, 0xc0c0c0c0, 0xf8f8f8ff, 0xc0c0c0c0, 0xf0f0f0ff, 0xc0c0c0c0, 0xe0e0e2ff, 0xc0c0c0c0,
+	0xc0c0c6ff, 0xc0c0c0c0, 0x80808eff, 0xc0c0c0c0, 0x00001eff, 0xc0c0c0c0, 0x00003eff, 0xc0c0c0c0,
+	0xf8fcfeff, 0xe0e0e0f8, 0xf8f8feff, 0xe0e0e0f8, 0xf0f0feff, 0xe0e0e0f0, 0xe0e0feff, 0xe0e0e0e0,
+	0xc0c0feff, 0xe0e0e0c0, 0x8080feff, 0xc0c0c080, 0x0000feff, 0xc0c0c000, 0x0000feff, 0xc0c0c000,
+	0xf8fcfeff, 0xf0f0f8f8, 0xf8fcfeff, 0xf0f0f8f8, 0xf0fcfeff, 0xf0f0f0f0, 0xe0fcfeff, 0xf0f0e0e0,
+	0xc0f8feff, 0xe0e0c0c0, 0x80f0feff, 0xc0c08080, 0x00e0feff, 0xc0c00000, 0x00c0feff, 0xc0c00000,
+	0xf8fcfeff, 0xf8f8f8f8, 0xfcfcfeff, 0xf8f8f8f8, 0xf8fcfeff, 0xf8f0f0f0, 0xf0fcfeff, 0xf0e0e0e0,
+	0xe0f8feff, 0xe0c0c0c0, 0xc0f0feff, 0xc0808080, 0xc0e0feff, 0xc0000000, 0xc0c0feff, 0xc0000000,
+	0xf8f8feff, 0xf8f8f8f8, 0xf8f8feff, 0xf8f8f8f8, 0xf8f8feff, 0xf0f0f0f8, 0xf0f0feff, 0xe0e0e0f0,
+	0xe0e0feff, 0xc0c0c0e0, 0xc0c0feff, 0x808080c0, 0xc0c0feff, 0x000000c0, 0xc0c0feff, 0x000000c0,
+	0xf0f0feff, 0xf8f8f0f0, 0xf0f0feff, 0xf8f8f0f0, 0xf0f0feff, 0xf0f0f0f0, 0xf0f0feff, 0xe0e0f0f0,
+	0xe0e0feff, 0xc0c0e0e0, 0xc0c0feff, 0x8080c0c0, 0xc0c0feff, 0x0000c0c0, 0xc0c0feff, 0x0000c0c0,
+	0x808080fc, 0x80808080, 0x808080f8, 0x80808080, 0x808080f0, 0x80808080, 0x808080e0, 0x80808080,
+	0x808080c4, 0x80808080, 0x8080808c, 0x80808080, 0x8080001c, 0x80808080, 0x8080003c, 0x80808080,
+	0x00000000, 0x00000000, 0x8080f8f8, 0x80808080, 0x8080f0f0, 0x80808080, 0x8080e0e0, 0x80808080,
+	0x8080c0c4, 0x80808080, 0x8080808c, 0x80808080, 0x8000001c, 0x80808080, 0x8000003c, 0x80808080,
+	0x80f0f0ff, 0x80808080, 0x80f8f8ff, 0x80808080, 0x80f0f0fc, 0x80808080, 0x80e0e0fc, 0x80808080,
+	0x80c0c0fc, 0x80808080, 0x808080fc, 0x80808080, 0x000000fc, 0x80808080, 0x000000fc, 0x80808080,
+	0xf0f0fcfc, 0xc0c0c0c0, 0xf8f8fcfc, 0xc0c0c0c0, 0xf0f0fcfc, 0xc0c0c0c0, 0xe0e0fcfc, 0xc0c0c0c0,
+	0xc0c0f0fc, 0xc0c0c0c0, 0x8080e0fc, 0x80808080, 0x0000c0fc, 0x80808000, 0x000080fc, 0x80808000,
+	0xf0f0fcfc, 0xe0e0e0f0, 0xf8fcfcfc, 0xe0e0e0f8, 0xf0fcfcfc, 0xe0e0e0f0, 0xe0f8fcfc, 0xe0e0e0e0,
+	0xc0f0f0fc, 0xc0c0c0c0, 0x80e0e0fc, 0x80808080, 0x00c0c0fc, 0x80800000, 0x008080fc, 0x80800000,
+	0xf0f0fcfc, 0xf0f0f0f0, 0xf8f8fcfc, 0xf0f0f8f8, 0xf0f0fcfc, 0xf0f0f0f0, 0xe0e0fcfc, 0xe0e0e0e0,
+	0xc0c0f0fc, 0xc0c0c0c0, 0x8080e0fc, 0x80808080, 0x8080c0fc, 0x80000000, 0x808080fc, 0x80000000,
+	0xf0f0f0fc, 0xf0f0f0f0, 0xf0f0f0fc, 0xf8f8f0f0, 0xf0f0f0fc, 0xf0f0f0f0, 0xe0e0e0fc, 0xe0e0e0e0,
+	0xc0c0c0fc, 0xc0c0c0c0, 0x808080fc, 0x80808080, 0x808080fc, 0x00000080, 0x808080fc, 0x00000080,
+	0xe0e0e0fc, 0xf0e0e0e0, 0xe0e0e0fc, 0xf8e0e0e0, 0xe0e0e0fc, 0xf0e0e0e0, 0xe0e0e0fc, 0xe0e0e0e0,
+	0xc0c0c0fc, 0xc0c0c0c0, 0x808080fc, 0x80808080, 0x808080fc, 0x00008080, 0x808080fc, 0x00008080,
+	0xf8f8fcfc, 0xfff8f8f8, 0xf8f8f8f8, 0xfff8f8f8, 0xf0f0f1f1, 0xfff0f0f0, 0xf0f0e1e3, 0xfff0f0f0,
+	0xe0e0c5c7, 0xffe0e0e0, 0xc0c08d8f, 0xffc0c0c0, 0x80801d1f, 0xe0808080, 0x80803d3f, 0xc0808080,
+	0xfcf8fcfc, 0xfffffcff, 0x00000000, 0x00000000, 0xf9f0f1f1, 0xfffff9ff, 0xf1e0e1e3, 0xfffff1fd,
+	0xe0c0c5c7, 0xffffe0f8, 0xc0808d8f, 0xfff0c0f0, 0x80001d1f, 0xe0e080e0, 0x80003d3f, 0xc0c080c0,
+	0xfcf8fcff, 0xfffffffe, 0xf8f8f8ff, 0xfffffffd, 0xf1f0f1ff, 0xfffffffb, 0xe1e0e1ff, 0xfffffff5,
+	0xc0c0c5ff, 0xfffff8e8, 0x80808dff, 0xfff0f0d0, 0x00001dff, 0xe0e0e0a0, 0x00003dff, 0xc0c0c0c0,
+	0xfcf8fdff, 0xfffffffc, 0xf8f8fdff, 0xfffffff8, 0xf1f0fdff, 0xfffffff1, 0xe1e0fdff, 0xffffffe1,
+	0xc0c0fdff, 0xfffff8c0, 0x8080fdff, 0xfff0f080, 0x0000fdff, 0xe0e0e000, 0x0000fdff, 0xc0c0c000,
+	0xfcf8fdff, 0xfffffcfc, 0xf8f8fdff, 0xfffff8f8, 0xf1f8fdff, 0xfffff1f1, 0xe1f8fdff, 0xffffe3e1,
+	0xc0f8fdff, 0xffffc0c0, 0x80f0fdff, 0xfff08080, 0x00e0fdff, 0xe0e00000, 0x00c0fdff, 0xc0c00000,
+	0xf8f8fdff, 0xfffcfcfc, 0xf8f8fdff, 0xfff8f8f8, 0xf8f8fdff, 0xfff1f1f1, 0xf0f8fdff, 0xffe3e1e1,
+	0xe0f8fdff, 0xffc7c0c0, 0xc0f0fdff, 0xff808080, 0x80e0fdff, 0xe0000000, 0x80c0fdff, 0xc0000000,
+	0xf0f0fdff, 0xfcfcfcf0, 0xf0f0fdff, 0xf8f8f8f0, 0xf0f0fdff, 0xf1f1f1f0, 0xf0f0fdff, 0xe3e1e1f0,
+	0xe0e0fdff, 0xc7c0c0e0, 0xc0c0fdff, 0x8f8080c0, 0x8080fdff, 0x00000080, 0x8080fdff, 0x00000080,
+	0xe0e0fdff, 0xfcfce0e0, 0xe0e0fdff, 0xf8f8e0e0, 0xe0e0fdff, 0xf1f1e0e0, 0xe0e0fdff, 0xe1e1e0e0,
+	0xe0e0fdff, 0xc0c0e0e0, 0xc0c0fdff, 0x8080c0c0, 0x8080fdff, 0x00008080, 0x8080fdff, 0x00008080,
+	0xf0f0f8fc, 0xf0f0f0f0, 0xf0f0f8f8, 0xf0f0f0f0, 0xe0e0e0f1, 0xe0e0e0e0, 0xe0e0e0e0, 0xe0e0e0e0,
+	0xc0c0c0c0, 0xc0c0c0c0, 0x80808088, 0x80808080, 0x00000018, 0x00000000, 0x00000038, 0x00000000,
+	0xf8f8fcfc, 0xfff8f8f8, 0x00000000, 0x00000000, 0xf0f0f1f1, 0xfff0f0f0, 0xe0e0e0e0, 0xffe0e0e0,
+	0xc0c0c0c0, 0xffc0c0c0, 0x80808088, 0xe0808080, 0x00000018, 0xc0000000, 0x00000038, 0x80000000,
+	0xfcfcfcff, 0xfffffcfd, 0xf8f8f8ff, 0xfffff8fa, 0xf1f1f1ff, 0xfffff1f5, 0xe0e0e0f8, 0xffffe0e8,
+	0xc0c0c0f8, 0xfff0c0d0, 0x808080f8, 0xe0e080a0, 0x000000f8, 0xc0c00040, 0x000000f8, 0x80808080,
+	0xfcf8f8f8, 0xfffffffc, 0xf8f8f8f8, 0xfffffff8, 0xf1f0f8f8, 0xfffffff1, 0xe0e0f8f8, 0xfffff8e0,
+	0xc0c0f8f8, 0xfff0f0c0, 0x8080e0f8, 0xe0e0e080, 0x0000c0f8, 0xc0c0c000, 0x000080f8, 0x80808000,
+	0xf8f8f8f8, 0xfffffcfc, 0xf8f8f8f8, 0xfffff8f8, 0xf0f8f8f8, 0xfffff1f1, 0xe0f8f8f8, 0xffffe0e0,
+	0xc0f0f8f8, 0xfff0c0c0, 0x80e0e0f8, 0xe0e08080, 0x00c0c0f8, 0xc0c00000, 0x008080f8, 0x80800000,
+	0xf0f0f8f8, 0xfffcfcf0, 0xf0f0f8f8, 0xfff8f8f0, 0xf0f0f8f8, 0xfff1f1f0, 0xe0e0f8f8, 0xffe0e0e0,
+	0xc0c0f8f8, 0xffc0c0c0, 0x8080e0f8, 0xe0808080, 0x0000c0f8, 0xc0000000, 0x000080f8, 0x80000000,
+	0xe0e0e0f8, 0xfcfce0e0, 0xe0e0e0f8, 0xf8f8e0e0, 0xe0e0e0f8, 0xf1f1e0e0, 0xe0e0e0f8, 0xe0e0e0e0,
+	0xc0c0c0f8, 0xc0c0c0c0, 0x808080f8, 0x80808080, 0x000000f8, 0x00000000, 0x000000f8, 0x00000000,
+	0xc0c0c0f8, 0xfcc0c0c0, 0xc0c0c0f8, 0xf8c0c0c0, 0xc0c0c0f8, 0xf1c0c0c0, 0xc0c0c0f8, 0xe0c0c0c0,
+	0xc0c0c0f8, 0xc0c0c0c0, 0x808080f8, 0x80808080, 0x000000f8, 0x00000000, 0x000000f8, 0x00000000,
+	0xf0f0f8fc, 0xfff0f0f0, 0xf0f0f8f8, 0xfff0f0f0, 0xf1f1f1f1, 0xfff1f1f1, 0xe1e1e3e3, 0xffe1e1e1,
+	0xe1e1c3c7, 0xffe1e1e1, 0xc1c18b8f, 0xffc1c1c1, 0x80801b1f, 0xff808080, 0x00003b3f, 0xc0000000,
+	0xf8f0f8fc, 0xfffff8fb, 0xf9f0f8f8, 0xfffff9ff, 0x00000000, 0x00000000, 0xf3e1e3e3, 0xfffff3ff,
+	0xe3c1c3c7, 0xffffe3fb, 0xc1818b8f, 0xffffc1f1, 0x80001b1f, 0xffe080e0, 0x00003b3f, 0xc0c000c0,
+	0xf8f0f8ff, 0xfffffffa, 0xf8f0f8ff, 0xfffffffd, 0xf1f1f1ff, 0xfffffffb, 0xe3e1e3ff, 0xfffffff7,
+	0xc3c1c3ff, 0xffffffeb, 0x81818bff, 0xfffff1d1, 0x00001bff, 0xffe0e0a0, 0x00003bff, 0xc0c0c040,
+	0xf8f0fbff, 0xfffffff8, 0xf8f0fbff, 0xfffffff8, 0xf1f1fbff, 0xfffffff1, 0xe3e1fbff, 0xffffffe3,
+	0xc3c1fbff, 0xffffffc3, 0x8181fbff, 0xfffff181, 0x0000fbff, 0xffe0e000, 0x0000fbff, 0xc0c0c000,
+	0xf8f1fbff, 0xfffffcf8, 0xf8f1fbff, 0xfffff8f8, 0xf1f1fbff, 0xfffff1f1, 0xe3f1fbff, 0xffffe3e3,
+	0xc3f1fbff, 0xffffc7c3, 0x81f1fbff, 0xffff8181, 0x00e0fbff, 0xffe00000, 0x00c0fbff, 0xc0c00000,
+	0xf0f1fbff, 0xfffcf8f8, 0xf1f1fbff, 0xfff8f8f8, 0xf1f1fbff, 0xfff1f1f1, 0xf1f1fbff, 0xffe3e3e3,
+	0xe1f1fbff, 0xffc7c3c3, 0xc1f1fbff, 0xff8f8181, 0x80e0fbff, 0xff000000, 0x00c0fbff, 0xc0000000,
+	0xe0e0fbff, 0xfcf8f8e0, 0xe0e0fbff, 0xf8f8f8e0, 0xe0e0fbff, 0xf1f1f1e0, 0xe0e0fbff, 0xe3e3e3e0,
+	0xe0e0fbff, 0xc7c3c3e0, 0xc0c0fbff, 0x8f8181c0, 0x8080fbff, 0x1f000080, 0x0000fbff, 0x00000000,
+	0xc0c0fbff, 0xf8f8c0c0, 0xc0c0fbff, 0xf8f8c0c0, 0xc0c0fbff, 0xf1f1c0c0, 0xc0c0fbff, 0xe3e3c0c0,
+	0xc0c0fbff, 0xc3c3c0c0, 0xc0c0fbff, 0x8181c0c0, 0x8080fbff, 0x00008080, 0x0000fbff, 0x00000000,
+	0xe0e0f0f0, 0xe0e0e0e0, 0xe0e0f0f8, 0xe0e0e0e0, 0xe0e0f1f1, 0xe0e0e0e0, 0xc0c0c1e3, 0xc0c0c0c0,
+	0xc0c0c1c1, 0xc0c0c0c0, 0x80808181, 0x80808080, 0x00000011, 0x00000000, 0x00000031, 0x00000000,
+	0xf0f0f0f0, 0xfff0f0f0, 0xf0f0f8f8, 0xfff0f0f0, 0x00000000, 0x00000000, 0xe1e1e3e3, 0xffe1e1e1,
+	0xc1c1c1c1, 0xffc1c1c1, 0x80808181, 0xff808080, 0x00000011, 0xc0000000, 0x00000031, 0x80000000,
+	0xf0f0f0f1, 0xfffff0f1, 0xf8f8f8ff, 0xfffff8fa, 0xf1f1f1ff, 0xfffff1f5, 0xe3e3e3ff, 0xffffe3eb,
+	0xc1c1c1f1, 0xffffc1d1, 0x808081f1, 0xffe080a0, 0x000000f1, 0xc0c00040, 0x000000f1, 0x80800080,
+	0xf0f0f1f1, 0xfffff1f0, 0xf8f0f1f1, 0xfffffff8, 0xf1f1f1f1, 0xfffffff1, 0xe3e1f1f1, 0xffffffe3,
+	0xc1c1f1f1, 0xfffff1c1, 0x8080f1f1, 0xffe0e080, 0x0000c0f1, 0xc0c0c000, 0x000080f1, 0x80808000,
+	0xf0f1f1f1, 0xfffff0f0, 0xf0f1f1f1, 0xfffff8f8, 0xf1f1f1f1, 0xfffff1f1, 0xe1f1f1f1, 0xffffe3e3,
+	0xc1f1f1f1, 0xffffc1c1, 0x80e0f1f1, 0xffe08080, 0x00c0c0f1, 0xc0c00000, 0x008080f1, 0x80800000,
+	0xe0e0f1f1, 0xfff0f0e0, 0xe0e0f1f1, 0xfff8f8e0, 0xe0e0f1f1, 0xfff1f1e0, 0xe0e0f1f1, 0xffe3e3e0,
+	0xc0c0f1f1, 0xffc1c1c0, 0x8080f1f1, 0xff808080, 0x0000c0f1, 0xc0000000, 0x000080f1, 0x80000000,
+	0xc0c0c0f1, 0xf0f0c0c0, 0xc0c0c0f1, 0xf8f8c0c0, 0xc0c0c0f1, 0xf1f1c0c0, 0xc0c0c0f1, 0xe3e3c0c0,
+	0xc0c0c0f1, 0xc1c1c0c0, 0x808080f1, 0x80808080, 0x000000f1, 0x00000000, 0x000000f1, 0x00000000,
+	0x808080f1, 0xf0808080, 0x808080f1, 0xf8808080, 0x808080f1, 0xf1808080, 0x808080f1, 0xe3808080,
+	0x808080f1, 0xc1808080, 0x808080f1, 0x80808080, 0x000000f1, 0x00000000, 0x000000f1, 0x00000000,
+	0xe0e0f4fc, 0xffe0e0e0, 0xe1e1f0f8, 0xffe1e1e1, 0xe1e1f1f1, 0xffe1e1e1, 0xe3e3e3e3, 0xffe3e3e3,
+	0xc3c3c7c7, 0xffc3c3c3, 0xc3c3878f, 0xffc3c3c3, 0x8383171f, 0xff838383, 0x0101373f, 0xff010101,
+	0xe0e0f4fc, 0xffffe0e3, 0xf1e0f0f8, 0xfffff1f7, 0xf3e1f1f1, 0xfffff3ff, 0x00000000, 0x00000000,
+	0xe7c3c7c7, 0xffffe7ff, 0xc783878f, 0xffffc7f7, 0x8303171f, 0xffff83e3, 0x0101373f, 0xffc101c1,
+	0xe0e0f4ff, 0xffffe3e2, 0xf0e0f0ff, 0xfffffff5, 0xf1e1f1ff, 0xfffffffb, 0xe3e3e3ff, 0xfffffff7,
+	0xc7c3c7ff, 0xffffffef, 0x878387ff, 0xffffffd7, 0x030317ff, 0xffffe3a3, 0x010137ff, 0xffc1c141,
+	0xe0e0f7ff, 0xffffe3e0, 0xf0e0f7ff, 0xfffffff0, 0xf1e1f7ff, 0xfffffff1, 0xe3e3f7ff, 0xffffffe3,
+	0xc7c3f7ff, 0xffffffc7, 0x8783f7ff, 0xffffff87, 0x0303f7ff, 0xffffe303, 0x0101f7ff, 0xffc1c101,
+	0xe0e3f7ff, 0xffffe0e0, 0xf0e3f7ff, 0xfffff8f0, 0xf1e3f7ff, 0xfffff1f1, 0xe3e3f7ff, 0xffffe3e3,
+	0xc7e3f7ff, 0xffffc7c7, 0x87e3f7ff, 0xffff8f87, 0x03e3f7ff, 0xffff0303, 0x01c1f7ff, 0xffc10101,
+	0xe0e3f7ff, 0xfffce0e0, 0xe1e3f7ff, 0xfff8f0f0, 0xe3e3f7ff, 0xfff1f1f1, 0xe3e3f7ff, 0xffe3e3e3,
+	0xe3e3f7ff, 0xffc7c7c7, 0xc3e3f7ff, 0xff8f8787, 0x83e3f7ff, 0xff1f0303, 0x01c1f7ff, 0xff010101,
+	0xc0c0f7ff, 0xfce0e0c0, 0xc1c1f7ff, 0xf8f0f0c1, 0xc1c1f7ff, 0xf1f1f1c1, 0xc1c1f7ff, 0xe3e3e3c1,
+	0xc1c1f7ff, 0xc7c7c7c1, 0xc1c1f7ff, 0x8f8787c1, 0x8181f7ff, 0x1f030381, 0x0101f7ff, 0x3f010101,
+	0x8080f7ff, 0xe0e08080, 0x8080f7ff, 0xf0f08080, 0x8080f7ff, 0xf1f18080, 0x8080f7ff, 0xe3e38080,
+	0x8080f7ff, 0xc7c78080, 0x8080f7ff, 0x87878080, 0x8080f7ff, 0x03038080, 0x0000f7ff, 0x01010000,
+	0xc0c0e0e0, 0xc0c0c0c0, 0xc0c0e0e0, 0xc0c0c0c0, 0xc0c0e0f1, 0xc0c0c0c0, 0xc1c1e3e3, 0xc1c1c1c1,
+	0x818183c7, 0x81818181, 0x81818383, 0x81818181, 0x01010303, 0x01010101, 0x00000023, 0x00000000,
+	0xc0c0e0e0, 0xffc0c0c0, 0xe0e0e0e0, 0xffe0e0e0, 0xe1e1f1f1, 0xffe1e1e1, 0x00000000, 0x00000000,
+	0xc3c3c7c7, 0xffc3c3c3, 0x83838383, 0xff838383, 0x01010303, 0xff010101, 0x00000023, 0x80000000,
+	0xc0c0e0e3, 0xffc1c0c1, 0xe0e0e0e3, 0xffffe0e2, 0xf1f1f1ff, 0xfffff1f5, 0xe3e3e3ff, 0xffffe3eb,
+	0xc7c7c7ff, 0xffffc7d7, 0x838383e3, 0xffff83a3, 0x010103e3, 0xffc10141, 0x000000e3, 0x80800080,
+	0xc0c0e3e3, 0xffc1c1c0, 0xe0e0e3e3, 0xffffe3e0, 0xf1e1e3e3, 0xfffffff1, 0xe3e3e3e3, 0xffffffe3,
+	0xc7c3e3e3, 0xffffffc7, 0x8383e3e3, 0xffffe383, 0x0101e3e3, 0xffc1c101, 0x000080e3, 0x80808000,
+	0xc0c1e3e3, 0xffc1c0c0, 0xe0e3e3e3, 0xffffe0e0, 0xe1e3e3e3, 0xfffff1f1, 0xe3e3e3e3, 0xffffe3e3,
+	0xc3e3e3e3, 0xffffc7c7, 0x83e3e3e3, 0xffff8383, 0x01c1e3e3, 0xffc10101, 0x008080e3, 0x80800000,
+	0xc0c0e3e3, 0xffc0c0c0, 0xc0c0e3e3, 0xffe0e0c0, 0xc1c1e3e3, 0xfff1f1c1, 0xc1c1e3e3, 0xffe3e3c1,
+	0xc1c1e3e3, 0xffc7c7c1, 0x8181e3e3, 0xff838381, 0x0101e3e3, 0xff010101, 0x000080e3, 0x80000000,
+	0x808080e3, 0xc0c08080, 0x808080e3, 0xe0e08080, 0x808080e3, 0xf1f18080, 0x808080e3, 0xe3e38080,
+	0x808080e3, 0xc7c78080, 0x808080e3, 0x83838080, 0x000000e3, 0x01010000, 0x000000e3, 0x00000000,
+	0x000000e3, 0xc0000000, 0x000000e3, 0xe0000000, 0x000000e3, 0xf1000000, 0x000000e3, 0xe3000000,
+	0x000000e3, 0xc7000000, 0x000000e3, 0x83000000, 0x000000e3, 0x01000000, 0x000000e3, 0x00000000,
+	0xc0c0fcfc, 0xc0c0c0c0, 0xc0c0f8f8, 0xc0c0c0c0, 0xc0c0f1f1, 0xc0c0c0c0, 0xc0c0e3e3, 0xc0c0c0c0,
+	0xc0c0c7c7, 0xc0c0c0c0, 0xc0c08f8f, 0xc0c0c0c0, 0xc0c01f1f, 0xc0c0c0c0, 0xc0c03f3f, 0xc0c0c0c0,
+	0xc0f8fcfc, 0xc0c0c0c0, 0xc0f8f8f8, 0xc0c0c0c0, 0xc0f0f1f1, 0xc0c0c0c0, 0xc0e0e3e3, 0xc0c0c0c0,
+	0xc0c0c7c7, 0xc0c0c0c0, 0xc0808f8f, 0xc0c0c0c0, 0xc0001f1f, 0xc0c0c0c0, 0xc0003f3f, 0xc0c0c0c0,
+	0x00000000, 0x00000000, 0xf8f8f8ff, 0xc0c0c0c0, 0xf0f0f1ff, 0xc0c0c0c0, 0xe0e0e3ff, 0xc0c0c0c0,
+	0xc0c0c7ff, 0xc0c0c0c0, 0x80808fff, 0xc0c0c0c0, 0x00001fff, 0xc0c0c0c0, 0x00003fff, 0xc0c0c0c0,
+	0xf8fcffff, 0xe0e0e0f8, 0xf8f8ffff, 0xe0e0e0f8, 0xf0f0ffff, 0xe0e0e0f0, 0xe0e0ffff, 0xe0e0e0e0,
+	0xc0c0ffff, 0xe0e0e0c0, 0x8080ffff, 0xc0c0c080, 0x0000ffff, 0xc0c0c000, 0x0000ffff, 0xc0c0c000,
+	0xf8feffff, 0xf0f0f8f8, 0xf8feffff, 0xf0f0f8f8, 0xf0fcffff, 0xf0f0f0f0, 0xe0f8ffff, 0xf0f0e0e0,
+	0xc0f0ffff, 0xe0e0c0c0, 0x80e0ffff, 0xc0c08080, 0x00c0ffff, 0xc0c00000, 0x00c0ffff, 0xc0c00000,
+	0xf8fcffff, 0xf8f8f8f8, 0xfcfcffff, 0xf8f8f8f8, 0xf8fcffff, 0xf8f0f0f0, 0xf0f8ffff, 0xf0e0e0e0,
+	0xe0f0ffff, 0xe0c0c0c0, 0xc0e0ffff, 0xc0808080, 0xc0c0ffff, 0xc0000000, 0xc0c0ffff, 0xc0000000,
+	0xf8f8ffff, 0xf8f8f8f8, 0xf8f8ffff, 0xf8f8f8f8, 0xf8f8ffff, 0xf0f0f0f8, 0xf0f0ffff, 0xe0e0e0f0,
+	0xe0e0ffff, 0xc0c0c0e0, 0xc0c0ffff, 0x808080c0, 0xc0c0ffff, 0x000000c0, 0xc0c0ffff, 0x000000c0,
+	0xf0f0ffff, 0xf8f8f0f0, 0xf0f0ffff, 0xf8f8f0f0, 0xf0f0ffff, 0xf0f0f0f0, 0xf0f0ffff, 0xe0e0f0f0,
+	0xe0e0ffff, 0xc0c0e0e0, 0xc0c0ffff, 0x8080c0c0, 0xc0c0ffff, 0x0000c0c0, 0xc0c0ffff, 0x0000c0c0,
+	0x808080fc, 0x80808080, 0x808080f8, 0x80808080, 0x808080f1, 0x80808080, 0x808080e3, 0x80808080,
+	0x808080c7, 0x80808080, 0x8080808f, 0x80808080, 0x8080001f, 0x80808080, 0x8080003f, 0x80808080,
+	0x8080f0fc, 0x80808080, 0x8080f8f8, 0x80808080, 0x8080f0f1, 0x80808080, 0x8080e0e3, 0x80808080,
+	0x8080c0c7, 0x80808080, 0x8080808f, 0x80808080, 0x8000001f, 0x80808080, 0x8000003f, 0x80808080,
+	0x00000000, 0x00000000, 0x80f8f8ff, 0x80808080, 0x80f0f0ff, 0x80808080, 0x80e0e0ff, 0x80808080,
+	0x80c0c0ff, 0x80808080, 0x808080ff, 0x80808080, 0x000000ff, 0x80808080, 0x000000ff, 0x80808080,
+	0xf0f0ffff, 0xc0c0c0c0, 0xf8f8ffff, 0xc0c0c0c0, 0xf0f0fcff, 0xc0c0c0c0, 0xe0e0f8ff, 0xc0c0c0c0,
+	0xc0c0f0ff, 0xc0c0c0c0, 0x8080e0ff, 0x80808080, 0x0000c0ff, 0x80808000, 0x000080ff, 0x80808000,
+	0xf0f0fcff, 0xe0e0e0f0, 0xf8fcfcff, 0xe0e0e0f8, 0xf0f8f8ff, 0xe0e0e0f0, 0xe0f0f0ff, 0xe0e0e0e0,
+	0xc0e0e0ff, 0xc0c0c0c0, 0x80c0c0ff, 0x80808080, 0x008080ff, 0x80800000, 0x008080ff, 0x80800000,
+	0xf0f0f8ff, 0xf0f0f0f0, 0xf8f8f8ff, 0xf0f0f8f8, 0xf0f0f8ff, 0xf0f0f0f0, 0xe0e0f0ff, 0xe0e0e0e0,
+	0xc0c0e0ff, 0xc0c0c0c0, 0x8080c0ff, 0x80808080, 0x808080ff, 0x80000000, 0x808080ff, 0x80000000,
+	0xf0f0f0ff, 0xf0f0f0f0, 0xf0f0f0ff, 0xf8f8f0f0, 0xf0f0f0ff, 0xf0f0f0f0, 0xe0e0e0ff, 0xe0e0e0e0,
+	0xc0c0c0ff, 0xc0c0c0c0, 0x808080ff, 0x80808080, 0x808080ff, 0x00000080, 0x808080ff, 0x00000080,
+	0xe0e0e0ff, 0xf0e0e0e0, 0xe0e0e0ff, 0xf8e0e0e0, 0xe0e0e0ff, 0xf0e0e0e0, 0xe0e0e0ff, 0xe0e0e0e0,
+	0xc0c0c0ff, 0xc0c0c0c0, 0x808080ff, 0x80808080, 0x808080ff, 0x00008080, 0x808080ff, 0x00008080,
+	0xf0f8fcfc, 0xf0f0f0f0, 0xf0f8f8f8, 0xf0f0f0f0, 0xf0f8f1f1, 0xf0f0f0f0, 0xe0e0e3e3, 0xe0e0e0e0,
+	0xe0e0c7c7, 0xe0e0e0e0, 0xc0c08f8f, 0xc0c0c0c0, 0x80801f1f, 0x80808080, 0x80803f3f, 0x80808080,
+	0xf8fcfcfc, 0xf8f8f8f8, 0xf8f8f8f8, 0xf8f8f8f8, 0xf0f1f1f1, 0xf0f0f0f0, 0xf0e1e3e3, 0xf0f0f0f0,
+	0xe0c0c7c7, 0xe0e0e0e0, 0xc0808f8f, 0xc0c0c0c0, 0x80001f1f, 0x80808080, 0x80003f3f, 0x80808080,
+	0xf8fcfcff, 0xfffcfffc, 0x00000000, 0x00000000, 0xf0f1f1ff, 0xfff9fff9, 0xe0e1e3ff, 0xfff1fdf1,
+	0xc0c0c7ff, 0xffe0f8e0, 0x80808fff, 0xf0c0f0c0, 0x00001fff, 0xe080e080, 0x00003fff, 0xc080c080,
+	0xf8fcffff, 0xfffffefc, 0xf8f8ffff, 0xfffffdf8, 0xf0f1ffff, 0xfffffbf1, 0xe0e1ffff, 0xfffff5e1,
+	0xc0c0ffff, 0xfff8e8c0, 0x8080ffff, 0xf0f0d080, 0x0000ffff, 0xe0e0a000, 0x0000ffff, 0xc0c0c000,
+	0xf8fdffff, 0xfffffcfc, 0xf8fdffff, 0xfffff8f8, 0xf0fdffff, 0xfffff1f1, 0xe0f9ffff, 0xffffe1e1,
+	0xc0f0ffff, 0xfff8c0c0, 0x80e0ffff, 0xf0f08080, 0x00c0ffff, 0xe0e00000, 0x0080ffff, 0xc0c00000,
+	0xf8f8ffff, 0xfffcfcfc, 0xf8f8ffff, 0xfff8f8f8, 0xf8f8ffff, 0xfff1f1f1, 0xf0f8ffff, 0xffe3e1e1,
+	0xe0f0ffff, 0xffc0c0c0, 0xc0e0ffff, 0xf0808080, 0x80c0ffff, 0xe0000000, 0x8080ffff, 0xc0000000,
+	0xf0f0ffff, 0xfcfcfcf8, 0xf0f0ffff, 0xf8f8f8f8, 0xf0f0ffff, 0xf1f1f1f8, 0xf0f0ffff, 0xe3e1e1f0,
+	0xe0e0ffff, 0xc7c0c0e0, 0xc0c0ffff, 0x808080c0, 0x8080ffff, 0x00000080, 0x8080ffff, 0x00000080,
+	0xe0e0ffff, 0xfcfcf0e0, 0xe0e0ffff, 0xf8f8f0e0, 0xe0e0ffff, 0xf1f1f0e0, 0xe0e0ffff, 0xe1e1f0e0,
+	0xe0e0ffff, 0xc0c0e0e0, 0xc0c0ffff, 0x8080c0c0, 0x8080ffff, 0x00008080, 0x8080ffff, 0x00008080,
+	0xe0e0f0fc, 0xe0e0e0e0, 0xe0e0f0f8, 0xe0e0e0e0, 0xe0e0f0f1, 0xe0e0e0e0, 0xc0c0c0e3, 0xc0c0c0c0,
+	0xc0c0c0c7, 0xc0c0c0c0, 0x8080808f, 0x80808080, 0x0000001f, 0x00000000, 0x0000003f, 0x00000000,
+	0xf0f8fcfc, 0xf0f0f0f0, 0xf0f8f8f8, 0xf0f0f0f0, 0xe0e0f1f1, 0xe0e0e0e0, 0xe0e0e0e3, 0xe0e0e0e0,
+	0xc0c0c0c7, 0xc0c0c0c0, 0x8080808f, 0x80808080, 0x0000001f, 0x00000000, 0x0000003f, 0x00000000,
+	0xf8fcfcff, 0xf8f8f8f8, 0x00000000, 0x00000000, 0xf0f1f1ff, 0xf0f0f0f0, 0xe0e0e0ff, 0xe0e0e0e0,
+	0xc0c0c0ff, 0xc0c0c0c0, 0x808080ff, 0x80808080, 0x000000ff, 0x00000000, 0x000000ff, 0x00000000,
+	0xfcfcffff, 0xfffcfdfc, 0xf8f8ffff, 0xfff8faf8, 0xf1f1ffff, 0xfff1f5f1, 0xe0e0f8ff, 0xffe0e8e0,
+	0xc0c0f0ff, 0xf0c0d0c0, 0x8080e0ff, 0xe080a080, 0x0000c0ff, 0xc0004000, 0x000080ff, 0x80808000,
+	0xf8f8f8ff, 0xfffffcfc, 0xf8f8f8ff, 0xfffff8f8, 0xf0f8f8ff, 0xfffff1f1, 0xe0f0f0ff, 0xfff8e0e0,
+	0xc0e0e0ff, 0xf0f0c0c0, 0x80c0c0ff, 0xe0e08080, 0x008080ff, 0xc0c00000, 0x000000ff, 0x80800000,
+	0xf0f0f0ff, 0xfffcfcf8, 0xf0f0f0ff, 0xfff8f8f8, 0xf0f0f0ff, 0xfff1f1f0, 0xe0e0f0ff, 0xffe0e0e0,
+	0xc0c0e0ff, 0xf0c0c0c0, 0x8080c0ff, 0xe0808080, 0x000080ff, 0xc0000000, 0x000000ff, 0x80000000,
+	0xe0e0e0ff, 0xfcfcf0e0, 0xe0e0e0ff, 0xf8f8f0e0, 0xe0e0e0ff, 0xf1f1f0e0, 0xe0e0e0ff, 0xe0e0e0e0,
+	0xc0c0c0ff, 0xc0c0c0c0, 0x808080ff, 0x80808080, 0x000000ff, 0x00000000, 0x000000ff, 0x00000000,
+	0xc0c0c0ff, 0xfce0c0c0, 0xc0c0c0ff, 0xf8e0c0c0, 0xc0c0c0ff, 0xf1e0c0c0, 0xc0c0c0ff, 0xe0e0c0c0,
+	0xc0c0c0ff, 0xc0c0c0c0, 0x808080ff, 0x80808080, 0x000000ff, 0x00000000, 0x000000ff, 0x00000000,
+	0xe0f0fcfc, 0xe0e0e0e0, 0xe0f1f8f8, 0xe0e0e0e0, 0xe0f1f1f1, 0xe0e0e0e0, 0xe0f1e3e3, 0xe0e0e0e0,
+	0xc0c1c7c7, 0xc0c0c0c0, 0xc0c18f8f, 0xc0c0c0c0, 0x80801f1f, 0x80808080, 0x00003f3f, 0x00000000,
+	0xf0f8fcfc, 0xf0f0f0f0, 0xf0f8f8f8, 0xf0f0f0f0, 0xf1f1f1f1, 0xf1f1f1f1, 0xe1e3e3e3, 0xe1e1e1e1,
+	0xe1c3c7c7, 0xe1e1e1e1, 0xc1818f8f, 0xc1c1c1c1, 0x80001f1f, 0x80808080, 0x00003f3f, 0x00000000,
+	0xf0f8fcff, 0xfff8fbf8, 0xf0f8f8ff, 0xfff9fff9, 0x00000000, 0x00000000, 0xe1e3e3ff, 0xfff3fff3,
+	0xc1c3c7ff, 0xffe3fbe3, 0x81818fff, 0xffc1f1c1, 0x00001fff, 0xe080e080, 0x00003fff, 0xc000c000,
+	0xf0f8ffff, 0xfffffaf8, 0xf0f8ffff, 0xfffffdf8, 0xf1f1ffff, 0xfffffbf1, 0xe1e3ffff, 0xfffff7e3,
+	0xc1c3ffff, 0xffffebc3, 0x8181ffff, 0xfff1d181, 0x0000ffff, 0xe0e0a000, 0x0000ffff, 0xc0c04000,
+	0xf0f9ffff, 0xfffff8f8, 0xf0fbffff, 0xfffff8f8, 0xf1fbffff, 0xfffff1f1, 0xe1fbffff, 0xffffe3e3,
+	0xc1f3ffff, 0xffffc3c3, 0x81e1ffff, 0xfff18181, 0x00c0ffff, 0xe0e00000, 0x0080ffff, 0xc0c00000,
+	0xf0f1ffff, 0xfffcf8f8, 0xf1f1ffff, 0xfff8f8f8, 0xf1f1ffff, 0xfff1f1f1, 0xf1f1ffff, 0xffe3e3e3,
+	0xe1f1ffff, 0xffc7c3c3, 0xc1e1ffff, 0xff818181, 0x80c0ffff, 0xe0000000, 0x0080ffff, 0xc0000000,
+	0xe0e0ffff, 0xfcf8f8f0, 0xe0e0ffff, 0xf8f8f8f1, 0xe0e0ffff, 0xf1f1f1f1, 0xe0e0ffff, 0xe3e3e3f1,
+	0xe0e0ffff, 0xc7c3c3e1, 0xc0c0ffff, 0x8f8181c1, 0x8080ffff, 0x00000080, 0x0000ffff, 0x00000000,
+	0xc0c0ffff, 0xf8f8e0c0, 0xc0c0ffff, 0xf8f8e0c0, 0xc0c0ffff, 0xf1f1e0c0, 0xc0c0ffff, 0xe3e3e0c0,
+	0xc0c0ffff, 0xc3c3e0c0, 0xc0c0ffff, 0x8181c0c0, 0x8080ffff, 0x00008080, 0x0000ffff, 0x00000000,
+	0xc0c0e0fc, 0xc0c0c0c0, 0xc0c0e0f8, 0xc0c0c0c0, 0xc0c0e0f1, 0xc0c0c0c0, 0xc0c0e0e3, 0xc0c0c0c0,
+	0x808080c7, 0x80808080, 0x8080808f, 0x80808080, 0x0000001f, 0x00000000, 0x0000003f, 0x00000000,
+	0xe0f0f0fc, 0xe0e0e0e0, 0xe0f0f8f8, 0xe0e0e0e0, 0xe0f1f1f1, 0xe0e0e0e0, 0xc0c1e3e3, 0xc0c0c0c0,
+	0xc0c1c1c7, 0xc0c0c0c0, 0x8080808f, 0x80808080, 0x0000001f, 0x00000000, 0x0000003f, 0x00000000,
+	0xf0f0f0ff, 0xf0f0f0f0, 0xf0f8f8ff, 0xf0f0f0f0, 0x00000000, 0x00000000, 0xe1e3e3ff, 0xe1e1e1e1,
+	0xc1c1c1ff, 0xc1c1c1c1, 0x808080ff, 0x80808080, 0x000000ff, 0x00000000, 0x000000ff, 0x00000000,
+	0xf0f0f1ff, 0xfff0f1f0, 0xf8f8ffff, 0xfff8faf8, 0xf1f1ffff, 0xfff1f5f1, 0xe3e3ffff, 0xffe3ebe3,
+	0xc1c1f1ff, 0xffc1d1c1, 0x8080e0ff, 0xe080a080, 0x0000c0ff, 0xc0004000, 0x000080ff, 0x80008000,
+	0xf0f0f0ff, 0xfff1f0f0, 0xf0f1f1ff, 0xfffff8f8, 0xf1f1f1ff, 0xfffff1f1, 0xe1f1f1ff, 0xffffe3e3,
+	0xc1e1e1ff, 0xfff1c1c1, 0x80c0c0ff, 0xe0e08080, 0x008080ff, 0xc0c00000, 0x000000ff, 0x80800000,
+	0xe0e0e0ff, 0xfff0f0f0, 0xe0e0e0ff, 0xfff8f8f0, 0xe0e0e0ff, 0xfff1f1f1, 0xe0e0e0ff, 0xffe3e3e1,
+	0xc0c0e0ff, 0xffc1c1c1, 0x8080c0ff, 0xe0808080, 0x000080ff, 0xc0000000, 0x000000ff, 0x80000000,
+	0xc0c0c0ff, 0xf0f0e0c0, 0xc0c0c0ff, 0xf8f8e0c0, 0xc0c0c0ff, 0xf1f1e0c0, 0xc0c0c0ff, 0xe3e3e0c0,
+	0xc0c0c0ff, 0xc1c1c0c0, 0x808080ff, 0x80808080, 0x000000ff, 0x00000000, 0x000000ff, 0x00000000,
+	0x808080ff, 0xf0c08080, 0x808080ff, 0xf8c08080, 0x808080ff, 0xf1c08080, 0x808080ff, 0xe3c08080,
+	0x808080ff, 0xc1c08080, 0x808080ff, 0x80808080, 0x000000ff, 0x00000000, 0x000000ff, 0x00000000,
+	0xc0e0fcfc, 0xc0c0c0c0, 0xc0e0f8f8, 0xc0c0c0c0, 0xc1e3f1f1, 0xc1c1c1c1, 0xc1e3e3e3, 0xc1c1c1c1,
+	0xc1e3c7c7, 0xc1c1c1c1, 0x81838f8f, 0x81818181, 0x81831f1f, 0x81818181, 0x01013f3f, 0x01010101,
+	0xe0e0fcfc, 0xe0e0e0e0, 0xe1f0f8f8, 0xe1e1e1e1, 0xe1f1f1f1, 0xe1e1e1e1, 0xe3e3e3e3, 0xe3e3e3e3,
+	0xc3c7c7c7, 0xc3c3c3c3, 0xc3878f8f, 0xc3c3c3c3, 0x83031f1f, 0x83838383, 0x01013f3f, 0x01010101,
+	0xe0e0fcff, 0xffe0e3e0, 0xe0f0f8ff, 0xfff1f7f1, 0xe1f1f1ff, 0xfff3fff3, 0x00000000, 0x00000000,
+	0xc3c7c7ff, 0xffe7ffe7, 0x83878fff, 0xffc7f7c7, 0x03031fff, 0xff83e383, 0x01013fff, 0xc101c101,
+	0xe0e0ffff, 0xffe3e2e0, 0xe0f0ffff, 0xfffff5f0, 0xe1f1ffff, 0xfffffbf1, 0xe3e3ffff, 0xfffff7e3,
+	0xc3c7ffff, 0xffffefc7, 0x8387ffff, 0xffffd787, 0x0303ffff, 0xffe3a303, 0x0101ffff, 0xc1c14101,
+	0xe0e1ffff, 0xffe3e0e0, 0xe0f3ffff, 0xfffff0f0, 0xe1f7ffff, 0xfffff1f1, 0xe3f7ffff, 0xffffe3e3,
+	0xc3f7ffff, 0xffffc7c7, 0x83e7ffff, 0xffff8787, 0x03c3ffff, 0xffe30303, 0x0181ffff, 0xc1c10101,
+	0xe0e1ffff, 0xffe0e0e0, 0xe1e3ffff, 0xfff8f0f0, 0xe3e3ffff, 0xfff1f1f1, 0xe3e3ffff, 0xffe3e3e3,
+	0xe3e3ffff, 0xffc7c7c7, 0xc3e3ffff, 0xff8f8787, 0x83c3ffff, 0xff030303, 0x0181ffff, 0xc1010101,
+	0xc0c0ffff, 0xfce0e0e0, 0xc1c1ffff, 0xf8f0f0e1, 0xc1c1ffff, 0xf1f1f1e3, 0xc1c1ffff, 0xe3e3e3e3,
+	0xc1c1ffff, 0xc7c7c7e3, 0xc1c1ffff, 0x8f8787c3, 0x8181ffff, 0x1f030383, 0x0101ffff, 0x01010101,
+	0x8080ffff, 0xe0e0c080, 0x8080ffff, 0xf0f0c180, 0x8080ffff, 0xf1f1c180, 0x8080ffff, 0xe3e3c180,
+	0x8080ffff, 0xc7c7c180, 0x8080ffff, 0x8787c180, 0x8080ffff, 0x03038180, 0x0000ffff, 0x01010100,
+	0x8080c0fc, 0x80808080, 0x8080c0f8, 0x80808080, 0x8080c1f1, 0x80808080, 0x8080c1e3, 0x80808080,
+	0x8080c1c7, 0x80808080, 0x0000018f, 0x00000000, 0x0000011f, 0x00000000, 0x0000003f, 0x00000000,
+	0xc0c0c0fc, 0xc0c0c0c0, 0xc0e0e0f8, 0xc0c0c0c0, 0xc0e0f1f1, 0xc0c0c0c0, 0xc1e3e3e3, 0xc1c1c1c1,
+	0x8183c7c7, 0x81818181, 0x8183838f, 0x81818181, 0x0101011f, 0x01010101, 0x0000003f, 0x00000000,
+	0xc0c0c0ff, 0xc0c0c0c0, 0xe0e0e0ff, 0xe0e0e0e0, 0xe1f1f1ff, 0xe1e1e1e1, 0x00000000, 0x00000000,
+	0xc3c7c7ff, 0xc3c3c3c3, 0x838383ff, 0x83838383, 0x010101ff, 0x01010101, 0x000000ff, 0x00000000,
+	0xc0c0c1ff, 0xc1c0c1c0, 0xe0e0e3ff, 0xffe0e2e0, 0xf1f1ffff, 0xfff1f5f1, 0xe3e3ffff, 0xffe3ebe3,
+	0xc7c7ffff, 0xffc7d7c7, 0x8383e3ff, 0xff83a383, 0x0101c1ff, 0xc1014101, 0x000080ff, 0x80008000,
+	0xc0c0c0ff, 0xc1c1c0c0, 0xe0e1e1ff, 0xffe3e0e0, 0xe1e3e3ff, 0xfffff1f1, 0xe3e3e3ff, 0xffffe3e3,
+	0xc3e3e3ff, 0xffffc7c7, 0x83c3c3ff, 0xffe38383, 0x018181ff, 0xc1c10101, 0x000000ff, 0x80800000,
+	0xc0c0c0ff, 0xc1c0c0c0, 0xc0c0c1ff, 0xffe0e0e0, 0xc1c1c1ff, 0xfff1f1e1, 0xc1c1c1ff, 0xffe3e3e3,
+	0xc1c1c1ff, 0xffc7c7c3, 0x8181c1ff, 0xff838383, 0x010181ff, 0xc1010101, 0x000000ff, 0x80000000,
+	0x808080ff, 0xc0c0c080, 0x808080ff, 0xe0e0c080, 0x808080ff, 0xf1f1c180, 0x808080ff, 0xe3e3c180,
+	0x808080ff, 0xc7c7c180, 0x808080ff, 0x83838180, 0x000000ff, 0x01010100, 0x000000ff, 0x00000000,
+	0x000000ff, 0xc0800000, 0x000000ff, 0xe0800000, 0x000000ff, 0xf1800000, 0x000000ff, 0xe3800000,
+	0x000000ff, 0xc7800000, 0x000000ff, 0x83800000, 0x000000ff, 0x01000000, 0x000000ff, 0x00000000,
+	0xe0fffcfc, 0xe0e0e0e0, 0xe0fff8f8, 0xe0e0e0e0, 0xe0fff1f1, 0xe0e0e0e0, 0xe0ffe3e3, 0xe0e0e0e0,
+	0xe0ffc7c7, 0xe0e0e0e0, 0xe0ff8f8f, 0xe0e0e0e0, 0xe0ff1f1f, 0xe0e0e0e0, 0xe0ff3f3f, 0xe0e0e0e0,
+	0xe0fcfcfc, 0xe0e0e0e0, 0xe0f8f8f8, 0xe0e0e0e0, 0xe0f1f1f1, 0xe0e0e0e0, 0xe0e3e3e3, 0xe0e0e0e0,
+	0xe0c7c7c7, 0xe0e0e0e0, 0xe08f8f8f, 0xe0e0e0e0, 0xe01f1f1f, 0xe0e0e0e0, 0xe03f3f3f, 0xe0e0e0e0,
+	0xf8fcfcff, 0xe0e0e0e0, 0xf8f8f8ff, 0xe0e0e0e0, 0xf0f1f1ff, 0xe0e0e0e0, 0xe0e3e3ff, 0xe0e0e0e0,
+	0xc0c7c7ff, 0xe0e0e0e0, 0x808f8fff, 0xe0e0e0e0, 0x001f1fff, 0xe0e0e0e0, 0x203f3fff, 0xe0e0e0e0,
+	0x00000000, 0x00000000, 0xf8f8ffff, 0xe0e0e0f8, 0xf0f1ffff, 0xe0e0e0f0, 0xe0e3ffff, 0xe0e0e0e0,
+	0xc0c7ffff, 0xe0e0e0c0, 0x808fffff, 0xe0e0e080, 0x001fffff, 0xe0e0e000, 0x203fffff, 0xe0e0e020,
+	0xfcffffff, 0xf0f0f8f8, 0xf8ffffff, 0xf0f0f8f8, 0xf0ffffff, 0xf0f0f0f0, 0xe0ffffff, 0xf0f0e0e0,
+	0xc0ffffff, 0xe0e0c0c0, 0x80ffffff, 0xe0e08080, 0x00ffffff, 0xe0e00000, 0x20ffffff, 0xe0e02020,
+	0xfeffffff, 0xf8f8f8f8, 0xfeffffff, 0xf8f8f8f8, 0xfcffffff, 0xf8f0f0f0, 0xf8ffffff, 0xf0e0e0e0,
+	0xf0ffffff, 0xe0c0c0c0, 0xe0ffffff, 0xe0808080, 0xe0ffffff, 0xe0000000, 0xe0ffffff, 0xe0202020,
+	0xfcffffff, 0xf8f8f8f8, 0xfcffffff, 0xf8f8f8fc, 0xfcffffff, 0xf0f0f0f8, 0xf8ffffff, 0xe0e0e0f0,
+	0xf0ffffff, 0xc0c0c0e0, 0xe0ffffff, 0x808080e0, 0xe0ffffff, 0x000000e0, 0xe0ffffff, 0x202020e0,
+	0xf8ffffff, 0xf8f8f8f8, 0xf8ffffff, 0xf8f8f8f8, 0xf8ffffff, 0xf0f0f8f8, 0xf0ffffff, 0xe0e0f0f0,
+	0xe0ffffff, 0xc0c0e0e0, 0xe0ffffff, 0x8080e0e0, 0xe0ffffff, 0x0000e0e0, 0xe0ffffff, 0x2020e0e0,
+	0xc0c0fcfc, 0xc0c0c0c0, 0xc0c0f8f8, 0xc0c0c0c0, 0xc0c0f1f1, 0xc0c0c0c0, 0xc0c0e3e3, 0xc0c0c0c0,
+	0xc0c0c7c7, 0xc0c0c0c0, 0xc0c08f8f, 0xc0c0c0c0, 0xc0c01f1f, 0xc0c0c0c0, 0xc0c03f3f, 0xc0c0c0c0,
+	0xc0c0fcfc, 0xc0c0c0c0, 0xc0c0f8f8, 0xc0c0c0c0, 0xc0c0f1f1, 0xc0c0c0c0, 0xc0c0e3e3, 0xc0c0c0c0,
+	0xc0c0c7c7, 0xc0c0c0c0, 0xc0808f8f, 0xc0c0c0c0, 0xc0001f1f, 0xc0c0c0c0, 0xc0003f3f, 0xc0c0c0c0,
+	0xc0f0fcff, 0xc0c0c0c0, 0xc0f8f8ff, 0xc0c0c0c0, 0xc0f0f1ff, 0xc0c0c0c0, 0xc0e0e3ff, 0xc0c0c0c0,
+	0xc0c0c7ff, 0xc0c0c0c0, 0x80808fff, 0xc0c0c0c0, 0x00001fff, 0xc0c0c0c0, 0x00003fff, 0xc0c0c0c0,
+	0x00000000, 0x00000000, 0xf8f8ffff, 0xc0c0c0c0, 0xf0f0ffff, 0xc0c0c0c0, 0xe0e0ffff, 0xc0c0c0c0,
+	0xc0c0ffff, 0xc0c0c0c0, 0x8080ffff, 0xc0c0c080, 0x0000ffff, 0xc0c0c000, 0x0000ffff, 0xc0c0c000,
+	0xf0ffffff, 0xe0e0e0f0, 0xf8ffffff, 0xe0e0e0f8, 0xf0fcffff, 0xe0e0e0f0, 0xe0f8ffff, 0xe0e0e0e0,
+	0xc0f0ffff, 0xc0c0c0c0, 0x80e0ffff, 0xc0c08080, 0x00c0ffff, 0xc0c00000, 0x00c0ffff, 0xc0c00000,
+	0xf0fcffff, 0xf0f0f0f0, 0xfcfcffff, 0xf0f0f8f8, 0xf8f8ffff, 0xf0f0f0f0, 0xf0f0ffff, 0xe0e0e0e0,
+	0xe0e0ffff, 0xc0c0c0c0, 0xc0c0ffff, 0xc0808080, 0xc0c0ffff, 0xc0000000, 0xc0c0ffff, 0xc0000000,
+	0xf0f8ffff, 0xf0f0f0f0, 0xf8f8ffff, 0xf8f8f8f8, 0xf0f8ffff, 0xf0f0f0f0, 0xe0f0ffff, 0xe0e0e0e0,
+	0xc0e0ffff, 0xc0c0c0c0, 0xc0c0ffff, 0x808080c0, 0xc0c0ffff, 0x000000c0, 0xc0c0ffff, 0x000000c0,
+	0xf0f0ffff, 0xf0f0f0f0, 0xf0f0ffff, 0xf8f0f0f0, 0xf0f0ffff, 0xf0f0f0f0, 0xe0e0ffff, 0xe0e0e0e0,
+	0xc0c0ffff, 0xc0c0c0c0, 0xc0c0ffff, 0x8080c0c0, 0xc0c0ffff, 0x0000c0c0, 0xc0c0ffff, 0x0000c0c0,
+	0xe0fffcfc, 0xe0e0e0e0, 0xe0fff8f8, 0xe0e0e0e0, 0xe0fff1f1, 0xe0e0e0e0, 0xe0ffe3e3, 0xe0e0e0e0,
+	0xc0ffc7c7, 0xc0c0c0c0, 0xc0ff8f8f, 0xc0c0c0c0, 0xc0ff1f1f, 0xc0c0c0c0, 0xc0ff3f3f, 0xc0c0c0c0,
+	0xf8fcfcfc, 0xf0f0f0f0, 0xf8f8f8f8, 0xf0f0f0f0, 0xf8f1f1f1, 0xf0f0f0f0, 0xe0e3e3e3, 0xe0e0e0e0,
+	0xe0c7c7c7, 0xe0e0e0e0, 0xc08f8f8f, 0xc0c0c0c0, 0xc01f1f1f, 0xc0c0c0c0, 0xc03f3f3f, 0xc0c0c0c0,
+	0xfcfcfcff, 0xf8f8f8f8, 0xf8f8f8ff, 0xf8f8f8f8, 0xf1f1f1ff, 0xf0f0f0f0, 0xe1e3e3ff, 0xf0f0f0f0,
+	0xc0c7c7ff, 0xe0e0e0e0, 0x808f8fff, 0xc0c0c0c0, 0x001f1fff, 0xc0c0c0c0, 0x003f3fff, 0xc0c0c0c0,
+	0xfcfcffff, 0xfcfffcf8, 0x00000000, 0x00000000, 0xf1f1ffff, 0xf9fff9f0, 0xe1e3ffff, 0xf1fdf1e0,
+	0xc0c7ffff, 0xe0f8e0c0, 0x808fffff, 0xc0f0c080, 0x001fffff, 0xc0e0c000, 0x003fffff, 0xc0c0c000,
+	0xfcffffff, 0xfffefcf8, 0xf8ffffff, 0xfffdf8f8, 0xf1ffffff, 0xfffbf1f0, 0xe1ffffff, 0xfff5e1e0,
+	0xc0ffffff, 0xf8e8c0c0, 0x80ffffff, 0xf0d08080, 0x00ffffff, 0xe0e00000, 0x00ffffff, 0xc0c00000,
+	0xfdffffff, 0xfffcfcf8, 0xfdffffff, 0xfff8f8f8, 0xfdffffff, 0xfff1f1f0, 0xf9ffffff, 0xffe1e1e0,
+	0xf0ffffff, 0xf8c0c0c0, 0xe0ffffff, 0xf0808080, 0xc0ffffff, 0xe0000000, 0xc0ffffff, 0xc0000000,
+	0xf8ffffff, 0xfcfcfcf8, 0xf8ffffff, 0xf8f8f8f8, 0xf8ffffff, 0xf1f1f1f8, 0xf8ffffff, 0xe3e1e1f0,
+	0xf0ffffff, 0xc0c0c0e0, 0xe0ffffff, 0x808080c0, 0xc0ffffff, 0x000000c0, 0xc0ffffff, 0x000000c0,
+	0xf0ffffff, 0xfcfcf8f0, 0xf0ffffff, 0xf8f8f8f0, 0xf0ffffff, 0xf1f1f8f0, 0xf0ffffff, 0xe1e1f0f0,
+	0xe0ffffff, 0xc0c0e0e0, 0xc0ffffff, 0x8080c0c0, 0xc0ffffff, 0x0000c0c0, 0xc0ffffff, 0x0000c0c0,
+	0xc0c0fcfc, 0xc0c0c0c0, 0xc0c0f8f8, 0xc0c0c0c0, 0xc0c0f1f1, 0xc0c0c0c0, 0xc0c0e3e3, 0xc0c0c0c0,
+	0x8080c7c7, 0x80808080, 0x80808f8f, 0x80808080, 0x80801f1f, 0x80808080, 0x80803f3f, 0x80808080,
+	0xe0f0fcfc, 0xe0e0e0e0, 0xe0f0f8f8, 0xe0e0e0e0, 0xe0f0f1f1, 0xe0e0e0e0, 0xc0c0e3e3, 0xc0c0c0c0,
+	0xc0c0c7c7, 0xc0c0c0c0, 0x80808f8f, 0x80808080, 0x80001f1f, 0x80808080, 0x80003f3f, 0x80808080,
+	0xf8fcfcff, 0xf0f0f0f0, 0xf8f8f8ff, 0xf0f0f0f0, 0xe0f1f1ff, 0xe0e0e0e0, 0xe0e0e3ff, 0xe0e0e0e0,
+	0xc0c0c7ff, 0xc0c0c0c0, 0x80808fff, 0x80808080, 0x00001fff, 0x80808080, 0x00003fff, 0x80808080,
+	0xfcfcffff, 0xf8f8f8f8, 0x00000000, 0x00000000, 0xf1f1ffff, 0xf0f0f0f0, 0xe0e0ffff, 0xe0e0e0e0,
+	0xc0c0ffff, 0xc0c0c0c0, 0x8080ffff, 0x80808080, 0x0000ffff, 0x80808000, 0x0000ffff, 0x80808000,
+	0xfcffffff, 0xfcfdfcfc, 0xf8ffffff, 0xf8faf8f8, 0xf1ffffff, 0xf1f5f1f1, 0xe0f8ffff, 0xe0e8e0e0,
+	0xc0f0ffff, 0xc0d0c0c0, 0x80e0ffff, 0x80a08080, 0x00c0ffff, 0xc0c00000, 0x0080ffff, 0x80800000,
+	0xf8f8ffff, 0xfffcfcf8, 0xf8f8ffff, 0xfff8f8f8, 0xf8f8ffff, 0xfff1f1f0, 0xf0f0ffff, 0xf8e0e0e0,
+	0xe0e0ffff, 0xf0c0c0c0, 0xc0c0ffff, 0xe0808080, 0x8080ffff, 0xc0000000, 0x8080ffff, 0x80000000,
+	0xf0f0ffff, 0xfcfcf8f0, 0xf0f0ffff, 0xf8f8f8f0, 0xf0f0ffff, 0xf1f1f0f0, 0xe0f0ffff, 0xe0e0e0e0,
+	0xc0e0ffff, 0xc0c0c0c0, 0x80c0ffff, 0x80808080, 0x8080ffff, 0x00000080, 0x8080ffff, 0x00000080,
+	0xe0e0ffff, 0xfcf0e0e0, 0xe0e0ffff, 0xf8f0e0e0, 0xe0e0ffff, 0xf1f0e0e0, 0xe0e0ffff, 0xe0e0e0e0,
+	0xc0c0ffff, 0xc0c0c0c0, 0x8080ffff, 0x80808080, 0x8080ffff, 0x00008080, 0x8080ffff, 0x00008080,
+	0xc0fffcfc, 0xc0c0c0c0, 0xc0fff8f8, 0xc0c0c0c0, 0xc0fff1f1, 0xc0c0c0c0, 0xc0ffe3e3, 0xc0c0c0c0,
+	0xc0ffc7c7, 0xc0c0c0c0, 0x80ff8f8f, 0x80808080, 0x80ff1f1f, 0x80808080, 0x80ff3f3f, 0x80808080,
+	0xf0fcfcfc, 0xe0e0e0e0, 0xf1f8f8f8, 0xe0e0e0e0, 0xf1f1f1f1, 0xe0e0e0e0, 0xf1e3e3e3, 0xe0e0e0e0,
+	0xc1c7c7c7, 0xc0c0c0c0, 0xc18f8f8f, 0xc0c0c0c0, 0x801f1f1f, 0x80808080, 0x803f3f3f, 0x80808080,
+	0xf8fcfcff, 0xf0f0f0f0, 0xf8f8f8ff, 0xf0f0f0f0, 0xf1f1f1ff, 0xf1f1f1f1, 0xe3e3e3ff, 0xe1e1e1e1,
+	0xc3c7c7ff, 0xe1e1e1e1, 0x818f8fff, 0xc1c1c1c1, 0x001f1fff, 0x80808080, 0x003f3fff, 0x80808080,
+	0xf8fcffff, 0xf8fbf8f0, 0xf8f8ffff, 0xf9fff9f0, 0x00000000, 0x00000000, 0xe3e3ffff, 0xf3fff3e1,
+	0xc3c7ffff, 0xe3fbe3c1, 0x818fffff, 0xc1f1c181, 0x001fffff, 0x80e08000, 0x003fffff, 0x80c08000,
+	0xf8ffffff, 0xfffaf8f0, 0xf8ffffff, 0xfffdf8f0, 0xf1ffffff, 0xfffbf1f1, 0xe3ffffff, 0xfff7e3e1,
+	0xc3ffffff, 0xffebc3c1, 0x81ffffff, 0xf1d18181, 0x00ffffff, 0xe0a00000, 0x00ffffff, 0xc0c00000,
+	0xf9ffffff, 0xfff8f8f0, 0xfbffffff, 0xfff8f8f0, 0xfbffffff, 0xfff1f1f1, 0xfbffffff, 0xffe3e3e1,
+	0xf3ffffff, 0xffc3c3c1, 0xe1ffffff, 0xf1818181, 0xc0ffffff, 0xe0000000, 0x80ffffff, 0xc0000000,
+	0xf1ffffff, 0xfcf8f8f0, 0xf1ffffff, 0xf8f8f8f1, 0xf1ffffff, 0xf1f1f1f1, 0xf1ffffff, 0xe3e3e3f1,
+	0xf1ffffff, 0xc7c3c3e1, 0xe1ffffff, 0x818181c1, 0xc0ffffff, 0x00000080, 0x80ffffff, 0x00000080,
+	0xe0ffffff, 0xf8f8f0e0, 0xe0ffffff, 0xf8f8f1e0, 0xe0ffffff, 0xf1f1f1e0, 0xe0ffffff, 0xe3e3f1e0,
+	0xe0ffffff, 0xc3c3e1e0, 0xc0ffffff, 0x8181c1c0, 0x80ffffff, 0x00008080, 0x80ffffff, 0x00008080,
+	0x8080fcfc, 0x80808080, 0x8080f8f8, 0x80808080, 0x8080f1f1, 0x80808080, 0x8080e3e3, 0x80808080,
+	0x8080c7c7, 0x80808080, 0x00008f8f, 0x00000000, 0x00001f1f, 0x00000000, 0x00003f3f, 0x00000000,
+	0xc0e0fcfc, 0xc0c0c0c0, 0xc0e0f8f8, 0xc0c0c0c0, 0xc0e0f1f1, 0xc0c0c0c0, 0xc0e0e3e3, 0xc0c0c0c0,
+	0x8080c7c7, 0x80808080, 0x80808f8f, 0x80808080, 0x00001f1f, 0x00000000, 0x00003f3f, 0x00000000,
+	0xf0f0fcff, 0xe0e0e0e0, 0xf0f8f8ff, 0xe0e0e0e0, 0xf1f1f1ff, 0xe0e0e0e0, 0xc1e3e3ff, 0xc0c0c0c0,
+	0xc1c1c7ff, 0xc0c0c0c0, 0x80808fff, 0x80808080, 0x00001fff, 0x00000000, 0x00003fff, 0x00000000,
+	0xf0f0ffff, 0xf0f0f0f0, 0xf8f8ffff, 0xf0f0f0f0, 0x00000000, 0x00000000, 0xe3e3ffff, 0xe1e1e1e1,
+	0xc1c1ffff, 0xc1c1c1c1, 0x8080ffff, 0x80808080, 0x0000ffff, 0x00000000, 0x0000ffff, 0x00000000,
+	0xf0f1ffff, 0xf0f1f0f0, 0xf8ffffff, 0xf8faf8f8, 0xf1ffffff, 0xf1f5f1f1, 0xe3ffffff, 0xe3ebe3e3,
+	0xc1f1ffff, 0xc1d1c1c1, 0x80e0ffff, 0x80a08080, 0x00c0ffff, 0x00400000, 0x0080ffff, 0x80800000,
+	0xf0f0ffff, 0xf1f0f0f0, 0xf1f1ffff, 0xfff8f8f0, 0xf1f1ffff, 0xfff1f1f1, 0xf1f1ffff, 0xffe3e3e1,
+	0xe1e1ffff, 0xf1c1c1c1, 0xc0c0ffff, 0xe0808080, 0x8080ffff, 0xc0000000, 0x0000ffff, 0x80000000,
+	0xe0e0ffff, 0xf0f0f0e0, 0xe0e0ffff, 0xf8f8f0e0, 0xe0e0ffff, 0xf1f1f1e0, 0xe0e0ffff, 0xe3e3e1e0,
+	0xc0e0ffff, 0xc1c1c1c0, 0x80c0ffff, 0x80808080, 0x0080ffff, 0x00000000, 0x0000ffff, 0x00000000,
+	0xc0c0ffff, 0xf0e0c0c0, 0xc0c0ffff, 0xf8e0c0c0, 0xc0c0ffff, 0xf1e0c0c0, 0xc0c0ffff, 0xe3e0c0c0,
+	0xc0c0ffff, 0xc1c0c0c0, 0x8080ffff, 0x80808080, 0x0000ffff, 0x00000000, 0x0000ffff, 0x00000000,
+	0x80fffcfc, 0x80808080, 0x80fff8f8, 0x80808080, 0x80fff1f1, 0x80808080, 0x80ffe3e3, 0x80808080,
+	0x80ffc7c7, 0x80808080, 0x80ff8f8f, 0x80808080, 0x00ff1f1f, 0x00000000, 0x00ff3f3f, 0x00000000,
+	0xe0fcfcfc, 0xc0c0c0c0, 0xe0f8f8f8, 0xc0c0c0c0, 0xe3f1f1f1, 0xc1c1c1c1, 0xe3e3e3e3, 0xc1c1c1c1,
+	0xe3c7c7c7, 0xc1c1c1c1, 0x838f8f8f, 0x81818181, 0x831f1f1f, 0x81818181, 0x013f3f3f, 0x01010101,
+	0xe0fcfcff, 0xe0e0e0e0, 0xf0f8f8ff, 0xe1e1e1e1, 0xf1f1f1ff, 0xe1e1e1e1, 0xe3e3e3ff, 0xe3e3e3e3,
+	0xc7c7c7ff, 0xc3c3c3c3, 0x878f8fff, 0xc3c3c3c3, 0x031f1fff, 0x83838383, 0x013f3fff, 0x01010101,
+	0xe0fcffff, 0xe0e3e0e0, 0xf0f8ffff, 0xf1f7f1e0, 0xf1f1ffff, 0xf3fff3e1, 0x00000000, 0x00000000,
+	0xc7c7ffff, 0xe7ffe7c3, 0x878fffff, 0xc7f7c783, 0x031fffff, 0x83e38303, 0x013fffff, 0x01c10101,
+	0xe0ffffff, 0xe3e2e0e0, 0xf0ffffff, 0xfff5f0e0, 0xf1ffffff, 0xfffbf1e1, 0xe3ffffff, 0xfff7e3e3,
+	0xc7ffffff, 0xffefc7c3, 0x87ffffff, 0xffd78783, 0x03ffffff, 0xe3a30303, 0x01ffffff, 0xc1410101,
+	0xe1ffffff, 0xe3e0e0e0, 0xf3ffffff, 0xfff0f0e0, 0xf7ffffff, 0xfff1f1e1, 0xf7ffffff, 0xffe3e3e3,
+	0xf7ffffff, 0xffc7c7c3, 0xe7ffffff, 0xff878783, 0xc3ffffff, 0xe3030303, 0x81ffffff, 0xc1010101,
+	0xe1ffffff, 0xe0e0e0e0, 0xe3ffffff, 0xf8f0f0e1, 0xe3ffffff, 0xf1f1f1e3, 0xe3ffffff, 0xe3e3e3e3,
+	0xe3ffffff, 0xc7c7c7e3, 0xe3ffffff, 0x8f8787c3, 0xc3ffffff, 0x03030383, 0x81ffffff, 0x01010101,
+	0xc0ffffff, 0xe0e0e0c0, 0xc1ffffff, 0xf0f0e1c1, 0xc1ffffff, 0xf1f1e3c1, 0xc1ffffff, 0xe3e3e3c1,
+	0xc1ffffff, 0xc7c7e3c1, 0xc1ffffff, 0x8787c3c1, 0x81ffffff, 0x03038381, 0x01ffffff, 0x01010101,
+	0x0000fcfc, 0x00000000, 0x0000f8f8, 0x00000000, 0x0000f1f1, 0x00000000, 0x0000e3e3, 0x00000000,
+	0x0000c7c7, 0x00000000, 0x00008f8f, 0x00000000, 0x00001f1f, 0x00000000, 0x00003f3f, 0x00000000,
+	0x80c0fcfc, 0x80808080, 0x80c0f8f8, 0x80808080, 0x80c1f1f1, 0x80808080, 0x80c1e3e3, 0x80808080,
+	0x80c1c7c7, 0x80808080, 0x00018f8f, 0x00000000, 0x00011f1f, 0x00000000, 0x00003f3f, 0x00000000,
+	0xc0c0fcff, 0xc0c0c0c0, 0xe0e0f8ff, 0xc0c0c0c0, 0xe0f1f1ff, 0xc0c0c0c0, 0xe3e3e3ff, 0xc1c1c1c1,
+	0x83c7c7ff, 0x81818181, 0x83838fff, 0x81818181, 0x01011fff, 0x01010101, 0x00003fff, 0x00000000,
+	0xc0c0ffff, 0xc0c0c0c0, 0xe0e0ffff, 0xe0e0e0e0, 0xf1f1ffff, 0xe1e1e1e1, 0x00000000, 0x00000000,
+	0xc7c7ffff, 0xc3c3c3c3, 0x8383ffff, 0x83838383, 0x0101ffff, 0x01010101, 0x0000ffff, 0x00000000,
+	0xc0c1ffff, 0xc0c1c0c0, 0xe0e3ffff, 0xe0e2e0e0, 0xf1ffffff, 0xf1f5f1f1, 0xe3ffffff, 0xe3ebe3e3,
+	0xc7ffffff, 0xc7d7c7c7, 0x83e3ffff, 0x83a38383, 0x01c1ffff, 0x01410101, 0x0080ffff, 0x00800000,
+	0xc0c0ffff, 0xc1c0c0c0, 0xe1e1ffff, 0xe3e0e0e0, 0xe3e3ffff, 0xfff1f1e1, 0xe3e3ffff, 0xffe3e3e3,
+	0xe3e3ffff, 0xffc7c7c3, 0xc3c3ffff, 0xe3838383, 0x8181ffff, 0xc1010101, 0x0000ffff, 0x80000000,
+	0xc0c0ffff, 0xc0c0c0c0, 0xc0c1ffff, 0xe0e0e0c0, 0xc1c1ffff, 0xf1f1e1c1, 0xc1c1ffff, 0xe3e3e3c1,
+	0xc1c1ffff, 0xc7c7c3c1, 0x81c1ffff, 0x83838381, 0x0181ffff, 0x01010101, 0x0000ffff, 0x00000000,
+	0x8080ffff, 0xc0c08080, 0x8080ffff, 0xe0c08080, 0x8080ffff, 0xf1c18080, 0x8080ffff, 0xe3c18080,
+	0x8080ffff, 0xc7c18080, 0x8080ffff, 0x83818080, 0x0000ffff, 0x01010000, 0x0000ffff, 0x00000000,
+	0xfffffcfc, 0xf0f0f0f0, 0xfffff8f8, 0xf0f0f0f0, 0xfffff1f1, 0xf0f0f0f0, 0xffffe3e3, 0xf0f0f0f0,
+	0xffffc7c7, 0xf0f0f0f0, 0xffff8f8f, 0xf0f0f0f0, 0xffff1f1f, 0xf0f0f0f0, 0xffff3f3f, 0xf0f0f0f0,
+	0xfffcfcfc, 0xf0f0f0f0, 0xfff8f8f8, 0xf0f0f0f0, 0xfff1f1f1, 0xf0f0f0f0, 0xffe3e3e3, 0xf0f0f0f0,
+	0xffc7c7c7, 0xf0f0f0f0, 0xff8f8f8f, 0xf0f0f0f0, 0xff1f1f1f, 0xf0f0f0f0, 0xff3f3f3f, 0xf0f0f0f0,
+	0xfcfcfcff, 0xf0f0f0f0, 0xf8f8f8ff, 0xf0f0f0f0, 0xf1f1f1ff, 0xf0f0f0f0, 0xe3e3e3ff, 0xf0f0f0f0,
+	0xc7c7c7ff, 0xf0f0f0f0, 0x8f8f8fff, 0xf0f0f0f0, 0x1f1f1fff, 0xf0f0f0f0, 0x3f3f3fff, 0xf0f0f0f0,
+	0xfcfcffff, 0xf0f0f0f8, 0xf8f8ffff, 0xf0f0f0f8, 0xf1f1ffff, 0xf0f0f0f0, 0xe3e3ffff, 0xf0f0f0e0,
+	0xc7c7ffff, 0xf0f0f0c0, 0x8f8fffff, 0xf0f0f080, 0x1f1fffff, 0xf0f0f010, 0x3f3fffff, 0xf0f0f030,
+	0x00000000, 0x00000000, 0xf8ffffff, 0xf0f0f8f8, 0xf1ffffff, 0xf0f0f0f0, 0xe3ffffff, 0xf0f0e0e0,
+	0xc7ffffff, 0xf0f0c0c0, 0x8fffffff, 0xf0f08080, 0x1fffffff, 0xf0f01010, 0x3fffffff, 0xf0f03030,
+	0xffffffff, 0xf8f8f8fc, 0xffffffff, 0xf8f8f8f8, 0xffffffff, 0xf8f0f0f0, 0xffffffff, 0xf0e0e0e0,
+	0xffffffff, 0xf0c0c0c0, 0xffffffff, 0xf0808080, 0xffffffff, 0xf0101010, 0xffffffff, 0xf0303030,
+	0xffffffff, 0xf8f8f8fe, 0xffffffff, 0xf8f8f8fe, 0xffffffff, 0xf0f0f0fc, 0xffffffff, 0xe0e0e0f8,
+	0xffffffff, 0xc0c0c0f0, 0xffffffff, 0x808080f0, 0xffffffff, 0x101010f0, 0xffffffff, 0x303030f0,
+	0xffffffff, 0xf8f8f8fc, 0xffffffff, 0xf8f8fcfc, 0xffffffff, 0xf0f0f8fc, 0xffffffff, 0xe0e0f0f8,
+	0xffffffff, 0xc0c0f0f0, 0xffffffff, 0x8080f0f0, 0xffffffff, 0x1010f0f0, 0xffffffff, 0x3030f0f0,
+	0xe0fffcfc, 0xe0e0e0e0, 0xe0fff8f8, 0xe0e0e0e0, 0xe0fff1f1, 0xe0e0e0e0, 0xe0ffe3e3, 0xe0e0e0e0,
+	0xe0ffc7c7, 0xe0e0e0e0, 0xe0ff8f8f, 0xe0e0e0e0, 0xe0ff1f1f, 0xe0e0e0e0, 0xe0ff3f3f, 0xe0e0e0e0,
+	0xe0fcfcfc, 0xe0e0e0e0, 0xe0f8f8f8, 0xe0e0e0e0, 0xe0f1f1f1, 0xe0e0e0e0, 0xe0e3e3e3, 0xe0e0e0e0,
+	0xe0c7c7c7, 0xe0e0e0e0, 0xe08f8f8f, 0xe0e0e0e0, 0xe01f1f1f, 0xe0e0e0e0, 0xe03f3f3f, 0xe0e0e0e0,
+	0xe0fcfcff, 0xe0e0e0e0, 0xe0f8f8ff, 0xe0e0e0e0, 0xe0f1f1ff, 0xe0e0e0e0, 0xe0e3e3ff, 0xe0e0e0e0,
+	0xc0c7c7ff, 0xe0e0e0e0, 0x808f8fff, 0xe0e0e0e0, 0x001f1fff, 0xe0e0e0e0, 0x203f3fff, 0xe0e0e0e0,
+	0xf0fcffff, 0xe0e0e0e0, 0xf8f8ffff, 0xe0e0e0e0, 0xf0f1ffff, 0xe0e0e0e0, 0xe0e3ffff, 0xe0e0e0e0,
+	0xc0c7ffff, 0xe0e0e0c0, 0x808fffff, 0xe0e0e080, 0x001fffff, 0xe0e0e000, 0x203fffff, 0xe0e0e020,
+	0x00000000, 0x00000000, 0xf8ffffff, 0xe0e0e0f8, 0xf0ffffff, 0xe0e0e0f0, 0xe0ffffff, 0xe0e0e0e0,
+	0xc0ffffff, 0xe0e0c0c0, 0x80ffffff, 0xe0e08080, 0x00ffffff, 0xe0e00000, 0x20ffffff, 0xe0e02020,
+	0xffffffff, 0xf0f0f0f0, 0xffffffff, 0xf0f0f8f8, 0xfcffffff, 0xf0f0f0f0, 0xf8ffffff, 0xe0e0e0e0,
+	0xf0ffffff, 0xe0c0c0c0, 0xe0ffffff, 0xe0808080, 0xe0ffffff, 0xe0000000, 0xe0ffffff, 0xe0202020,
+	0xfcffffff, 0xf0f0f0f0, 0xfcffffff, 0xf8f8f8fc, 0xf8ffffff, 0xf0f0f0f8, 0xf0ffffff, 0xe0e0e0f0,
+	0xe0ffffff, 0xc0c0c0e0, 0xe0ffffff, 0x808080e0, 0xe0ffffff, 0x000000e0, 0xe0ffffff, 0x202020e0,
+	0xf8ffffff, 0xf0f0f0f0, 0xf8ffffff, 0xf8f8f8f8, 0xf8ffffff, 0xf0f0f0f0, 0xf0ffffff, 0xe0e0e0e0,
+	0xe0ffffff, 0xc0c0e0e0, 0xe0ffffff, 0x8080e0e0, 0xe0ffffff, 0x0000e0e0, 0xe0ffffff, 0x2020e0e0,
+	0xfffffcfc, 0xe0e0e0e0, 0xfffff8f8, 0xe0e0e0e0, 0xfffff1f1, 0xe0e0e0e0, 0xffffe3e3, 0xe0e0e0e0,
+	0xffffc7c7, 0xe0e0e0e0, 0xffff8f8f, 0xe0e0e0e0, 0xffff1f1f, 0xe0e0e0e0, 0xffff3f3f, 0xe0e0e0e0,
+	0xfffcfcfc, 0xe0e0e0e0, 0xfff8f8f8, 0xe0e0e0e0, 0xfff1f1f1, 0xe0e0e0e0, 0xffe3e3e3, 0xe0e0e0e0,
+	0xffc7c7c7, 0xe0e0e0e0, 0xff8f8f8f, 0xe0e0e0e0, 0xff1f1f1f, 0xe0e0e0e0, 0xff3f3f3f, 0xe0e0e0e0,
+	0xfcfcfcff, 0xf0f0f0f8, 0xf8f8f8ff, 0xf0f0f0f8, 0xf1f1f1ff, 0xf0f0f0f8, 0xe3e3e3ff, 0xe0e0e0e0,
+	0xc7c7c7ff, 0xe0e0e0e0, 0x8f8f8fff, 0xe0e0e0e0, 0x1f1f1fff, 0xe0e0e0e0, 0x3f3f3fff, 0xe0e0e0e0,
+	0xfcfcffff, 0xf8f8f8fc, 0xf8f8ffff, 0xf8f8f8f8, 0xf1f1ffff, 0xf0f0f0f1, 0xe3e3ffff, 0xf0f0f0e1,
+	0xc7c7ffff, 0xf0f0e0c0, 0x8f8fffff, 0xe0e0e080, 0x1f1fffff, 0xe0e0e000, 0x3f3fffff, 0xe0e0e020,
+	0xfcffffff, 0xfffcf8fc, 0x00000000, 0x00000000, 0xf1ffffff, 0xfff9f0f1, 0xe3ffffff, 0xfff9e0e1,
+	0xc7ffffff, 0xf8f0c0c0, 0x8fffffff, 0xf0e08080, 0x1fffffff, 0xe0e00000, 0x3fffffff, 0xe0e02020,
+	0xffffffff, 0xfffcf8fc, 0xffffffff, 0xfff8f8f8, 0xffffffff, 0xfff1f0f1, 0xffffffff, 0xffe1e0e1,
+	0xffffffff, 0xf8c0c0c0, 0xffffffff, 0xf0808080, 0xffffffff, 0xe0000000, 0xffffffff, 0xe0202020,
+	0xffffffff, 0xfcfcf8fd, 0xffffffff, 0xf8f8f8fd, 0xffffffff, 0xf1f1f0fd, 0xffffffff, 0xe3e1e0f9,
+	0xffffffff, 0xc0c0c0f0, 0xffffffff, 0x808080e0, 0xffffffff, 0x000000e0, 0xffffffff, 0x202020e0,
+	0xffffffff, 0xfcfcf8f8, 0xffffffff, 0xf8f8f8f8, 0xffffffff, 0xf1f1f8f8, 0xffffffff, 0xe1e1f0f8,
+	0xffffffff, 0xc0c0e0f0, 0xffffffff, 0x8080e0e0, 0xffffffff, 0x0000e0e0, 0xffffffff, 0x2020e0e0,
+	0xc0fffcfc, 0xc0c0c0c0, 0xc0fff8f8, 0xc0c0c0c0, 0xc0fff1f1, 0xc0c0c0c0, 0xc0ffe3e3, 0xc0c0c0c0,
+	0xc0ffc7c7, 0xc0c0c0c0, 0xc0ff8f8f, 0xc0c0c0c0, 0xc0ff1f1f, 0xc0c0c0c0, 0xc0ff3f3f, 0xc0c0c0c0,
+	0xc0fcfcfc, 0xc0c0c0c0, 0xc0f8f8f8, 0xc0c0c0c0, 0xc0f1f1f1, 0xc0c0c0c0, 0xc0e3e3e3, 0xc0c0c0c0,
+	0xc0c7c7c7, 0xc0c0c0c0, 0xc08f8f8f, 0xc0c0c0c0, 0xc01f1f1f, 0xc0c0c0c0, 0xc03f3f3f, 0xc0c0c0c0,
+	0xf0fcfcff, 0xe0e0e0e0, 0xf0f8f8ff, 0xe0e0e0e0, 0xf0f1f1ff, 0xe0e0e0e0, 0xc0e3e3ff, 0xc0c0c0c0,
+	0xc0c7c7ff, 0xc0c0c0c0, 0x808f8fff, 0xc0c0c0c0, 0x001f1fff, 0xc0c0c0c0, 0x003f3fff, 0xc0c0c0c0,
+	0xfcfcffff, 0xf0f0f0f8, 0xf8f8ffff, 0xf0f0f0f8, 0xf1f1ffff, 0xe0e0e0e0, 0xe0e3ffff, 0xe0e0e0e0,
+	0xc0c7ffff, 0xe0c0c0c0, 0x808fffff, 0xc0c0c080, 0x001fffff, 0xc0c0c000, 0x003fffff, 0xc0c0c000,
+	0xfcffffff, 0xf8f8f8fc, 0x00000000, 0x00000000, 0xf1ffffff, 0xf0f0f0f1, 0xe0ffffff, 0xf0f0e0e0,
+	0xc0ffffff, 0xe0e0c0c0, 0x80ffffff, 0xc0c08080, 0x00ffffff, 0xc0c00000, 0x00ffffff, 0xc0c00000,
+	0xffffffff, 0xfffcfcfc, 0xffffffff, 0xfff8f8f8, 0xffffffff, 0xfff1f1f1, 0xf8ffffff, 0xf8e0e0e0,
+	0xf0ffffff, 0xf0c0c0c0, 0xe0ffffff, 0xe0808080, 0xc0ffffff, 0xc0000000, 0xc0ffffff, 0xc0000000,
+	0xf8ffffff, 0xfcfcf8f8, 0xf8ffffff, 0xf8f8f8f8, 0xf8ffffff, 0xf1f1f0f8, 0xf0ffffff, 0xe0e0e0f0,
+	0xe0ffffff, 0xc0c0c0e0, 0xc0ffffff, 0x808080c0, 0xc0ffffff, 0x000000c0, 0xc0ffffff, 0x000000c0,
+	0xf0ffffff, 0xfcf8f0f0, 0xf0ffffff, 0xf8f8f0f0, 0xf0ffffff, 0xf1f0f0f0, 0xf0ffffff, 0xe0e0e0e0,
+	0xe0ffffff, 0xc0c0c0c0, 0xc0ffffff, 0x8080c0c0, 0xc0ffffff, 0x0000c0c0, 0xc0ffffff, 0x0000c0c0,
+	0xfffffcfc, 0xc0c0c0c0, 0xfffff8f8, 0xc0c0c0c0, 0xfffff1f1, 0xc0c0c0c0, 0xffffe3e3, 0xc0c0c0c0,
+	0xffffc7c7, 0xc0c0c0c0, 0xffff8f8f, 0xc0c0c0c0, 0xffff1f1f, 0xc0c0c0c0, 0xffff3f3f, 0xc0c0c0c0,
+	0xfffcfcfc, 0xc0c0c0c0, 0xfff8f8f8, 0xc0c0c0c0, 0xfff1f1f1, 0xc0c0c0c0, 0xffe3e3e3, 0xc0c0c0c0,
+	0xffc7c7c7, 0xc0c0c0c0, 0xff8f8f8f, 0xc0c0c0c0, 0xff1f1f1f, 0xc0c0c0c0, 0xff3f3f3f, 0xc0c0c0c0,
+	0xfcfcfcff, 0xe0e0e0f0, 0xf8f8f8ff, 0xe0e0e0f1, 0xf1f1f1ff, 0xe0e0e0f1, 0xe3e3e3ff, 0xe0e0e0f1,
+	0xc7c7c7ff, 0xc0c0c0c1, 0x8f8f8fff, 0xc0c0c0c1, 0x1f1f1fff, 0xc0c0c0c0, 0x3f3f3fff, 0xc0c0c0c0,
+	0xfcfcffff, 0xf0f0f0f8, 0xf8f8ffff, 0xf0f0f0f8, 0xf1f1ffff, 0xf1f1f1f1, 0xe3e3ffff, 0xe1e1e1e3,
+	0xc7c7ffff, 0xe1e1e1c3, 0x8f8fffff, 0xe1e1c181, 0x1f1fffff, 0xc0c0c000, 0x3f3fffff, 0xc0c0c000,
+	0xfcffffff, 0xfff9f0f8, 0xf8ffffff, 0xfff9f0f8, 0x00000000, 0x00000000, 0xe3ffffff, 0xfff3e1e3,
+	0xc7ffffff, 0xfff3c1c3, 0x8fffffff, 0xf1e18181, 0x1fffffff, 0xe0c00000, 0x3fffffff, 0xc0c00000,
+	0xffffffff, 0xfff8f0f8, 0xffffffff, 0xfff8f0f8, 0xffffffff, 0xfff1f1f1, 0xffffffff, 0xffe3e1e3,
+	0xffffffff, 0xffc3c1c3, 0xffffffff, 0xf1818181, 0xffffffff, 0xe0000000, 0xffffffff, 0xc0000000,
+	0xffffffff, 0xfcf8f0f9, 0xffffffff, 0xf8f8f0fb, 0xffffffff, 0xf1f1f1fb, 0xffffffff, 0xe3e3e1fb,
+	0xffffffff, 0xc7c3c1f3, 0xffffffff, 0x818181e1, 0xffffffff, 0x000000c0, 0xffffffff, 0x000000c0,
+	0xffffffff, 0xf8f8f0f1, 0xffffffff, 0xf8f8f1f1, 0xffffffff, 0xf1f1f1f1, 0xffffffff, 0xe3e3f1f1,
+	0xffffffff, 0xc3c3e1f1, 0xffffffff, 0x8181c1e1, 0xffffffff, 0x0000c0c0, 0xffffffff, 0x0000c0c0,
+	0x80fffcfc, 0x80808080, 0x80fff8f8, 0x80808080, 0x80fff1f1, 0x80808080, 0x80ffe3e3, 0x80808080,
+	0x80ffc7c7, 0x80808080, 0x80ff8f8f, 0x80808080, 0x80ff1f1f, 0x80808080, 0x80ff3f3f, 0x80808080,
+	0x80fcfcfc, 0x80808080, 0x80f8f8f8, 0x80808080, 0x80f1f1f1, 0x80808080, 0x80e3e3e3, 0x80808080,
+	0x80c7c7c7, 0x80808080, 0x808f8f8f, 0x80808080, 0x801f1f1f, 0x80808080, 0x803f3f3f, 0x80808080,
+	0xe0fcfcff, 0xc0c0c0c0, 0xe0f8f8ff, 0xc0c0c0c0, 0xe0f1f1ff, 0xc0c0c0c0, 0xe0e3e3ff, 0xc0c0c0c0,
+	0x80c7c7ff, 0x80808080, 0x808f8fff, 0x80808080, 0x001f1fff, 0x80808080, 0x003f3fff, 0x80808080,
+	0xf0fcffff, 0xe0e0e0f0, 0xf8f8ffff, 0xe0e0e0f0, 0xf1f1ffff, 0xe0e0e0f1, 0xe3e3ffff, 0xc0c0c0c1,
+	0xc1c7ffff, 0xc0c0c0c1, 0x808fffff, 0xc0808080, 0x001fffff, 0x80808000, 0x003fffff, 0x80808000,
+	0xf0ffffff, 0xf0f0f0f0, 0xf8ffffff, 0xf0f0f0f8, 0x00000000, 0x00000000, 0xe3ffffff, 0xe1e1e1e3,
+	0xc1ffffff, 0xe1e1c1c1, 0x80ffffff, 0xc0c08080, 0x00ffffff, 0x80800000, 0x00ffffff, 0x80800000,
+	0xf1ffffff, 0xf1f0f0f0, 0xffffffff, 0xfff8f8f8, 0xffffffff, 0xfff1f1f1, 0xffffffff, 0xffe3e3e3,
+	0xf1ffffff, 0xf1c1c1c1, 0xe0ffffff, 0xe0808080, 0xc0ffffff, 0xc0000000, 0x80ffffff, 0x80000000,
+	0xf0ffffff, 0xf0f0f0f0, 0xf1ffffff, 0xf8f8f0f1, 0xf1ffffff, 0xf1f1f1f1, 0xf1ffffff, 0xe3e3e1f1,
+	0xe1ffffff, 0xc1c1c1e1, 0xc0ffffff, 0x808080c0, 0x80ffffff, 0x00000080, 0x80ffffff, 0x00000080,
+	0xe0ffffff, 0xf0f0e0e0, 0xe0ffffff, 0xf8f0e0e0, 0xe0ffffff, 0xf1f1e0e0, 0xe0ffffff, 0xe3e1e0e0,
+	0xe0ffffff, 0xc1c1c0c0, 0xc0ffffff, 0x80808080, 0x80ffffff, 0x00008080, 0x80ffffff, 0x00008080,
+	0xfffffcfc, 0x80808080, 0xfffff8f8, 0x80808080, 0xfffff1f1, 0x80808080, 0xffffe3e3, 0x80808080,
+	0xffffc7c7, 0x80808080, 0xffff8f8f, 0x80808080, 0xffff1f1f, 0x80808080, 0xffff3f3f, 0x80808080,
+	0xfffcfcfc, 0x80808080, 0xfff8f8f8, 0x80808080, 0xfff1f1f1, 0x80808080, 0xffe3e3e3, 0x80808080,
+	0xffc7c7c7, 0x80808080, 0xff8f8f8f, 0x80808080, 0xff1f1f1f, 0x80808080, 0xff3f3f3f, 0x80808080,
+	0xfcfcfcff, 0xc0c0c0e0, 0xf8f8f8ff, 0xc0c0c0e0, 0xf1f1f1ff, 0xc1c1c1e3, 0xe3e3e3ff, 0xc1c1c1e3,
+	0xc7c7c7ff, 0xc1c1c1e3, 0x8f8f8fff, 0x81818183, 0x1f1f1fff, 0x81818183, 0x3f3f3fff, 0x81818181,
+	0xfcfcffff, 0xe1e1e0e0, 0xf8f8ffff, 0xe1e1e1f0, 0xf1f1ffff, 0xe1e1e1f1, 0xe3e3ffff, 0xe3e3e3e3,
+	0xc7c7ffff, 0xc3c3c3c7, 0x8f8fffff, 0xc3c3c387, 0x1f1fffff, 0xc3c38303, 0x3f3fffff, 0x81818101,
+	0xfcffffff, 0xe3e1e0e0, 0xf8ffffff, 0xfff3e0f0, 0xf1ffffff, 0xfff3e1f1, 0x00000000, 0x00000000,
+	0xc7ffffff, 0xffe7c3c7, 0x8fffffff, 0xffe78387, 0x1fffffff, 0xe3c30303, 0x3fffffff, 0xc1810101,
+	0xffffffff, 0xe3e0e0e0, 0xffffffff, 0xfff0e0f0, 0xffffffff, 0xfff1e1f1, 0xffffffff, 0xffe3e3e3,
+	0xffffffff, 0xffc7c3c7, 0xffffffff, 0xff878387, 0xffffffff, 0xe3030303, 0xffffffff, 0xc1010101,
+	0xffffffff, 0xe0e0e0e1, 0xffffffff, 0xf8f0e0f3, 0xffffffff, 0xf1f1e1f7, 0xffffffff, 0xe3e3e3f7,
+	0xffffffff, 0xc7c7c3f7, 0xffffffff, 0x8f8783e7, 0xffffffff, 0x030303c3, 0xffffffff, 0x01010181,
+	0xffffffff, 0xe0e0e0e1, 0xffffffff, 0xf0f0e1e3, 0xffffffff, 0xf1f1e3e3, 0xffffffff, 0xe3e3e3e3,
+	0xffffffff, 0xc7c7e3e3, 0xffffffff, 0x8787c3e3, 0xffffffff, 0x030383c3, 0xffffffff, 0x01018181,
+	0x00fffcfc, 0x00000000, 0x00fff8f8, 0x00000000, 0x00fff1f1, 0x00000000, 0x00ffe3e3, 0x00000000,
+	0x00ffc7c7, 0x00000000, 0x00ff8f8f, 0x00000000, 0x00ff1f1f, 0x00000000, 0x00ff3f3f, 0x00000000,
+	0x00fcfcfc, 0x00000000, 0x00f8f8f8, 0x00000000, 0x00f1f1f1, 0x00000000, 0x00e3e3e3, 0x00000000,
+	0x00c7c7c7, 0x00000000, 0x008f8f8f, 0x00000000, 0x001f1f1f, 0x00000000, 0x003f3f3f, 0x00000000,
+	0xc0fcfcff, 0x80808080, 0xc0f8f8ff, 0x80808080, 0xc1f1f1ff, 0x80808080, 0xc1e3e3ff, 0x80808080,
+	0xc1c7c7ff, 0x80808080, 0x018f8fff, 0x00000000, 0x011f1fff, 0x00000000, 0x003f3fff, 0x00000000,
+	0xc0fcffff, 0xc0c0c0c0, 0xe0f8ffff, 0xc0c0c0e0, 0xf1f1ffff, 0xc0c0c0e0, 0xe3e3ffff, 0xc1c1c1e3,
+	0xc7c7ffff, 0x81818183, 0x838fffff, 0x81818183, 0x011fffff, 0x81010101, 0x003fffff, 0x00000000,
+	0xc0ffffff, 0xc0c0c0c0, 0xe0ffffff, 0xe1e1e0e0, 0xf1ffffff, 0xe1e1e1f1, 0x00000000, 0x00000000,
+	0xc7ffffff, 0xc3c3c3c7, 0x83ffffff, 0xc3c38383, 0x01ffffff, 0x81810101, 0x00ffffff, 0x00000000,
+	0xc1ffffff, 0xc1c0c0c0, 0xe3ffffff, 0xe3e0e0e0, 0xffffffff, 0xfff1f1f1, 0xffffffff, 0xffe3e3e3,
+	0xffffffff, 0xffc7c7c7, 0xe3ffffff, 0xe3838383, 0xc1ffffff, 0xc1010101, 0x80ffffff, 0x80000000,
+	0xc0ffffff, 0xc0c0c0c0, 0xe1ffffff, 0xe0e0e0e1, 0xe3ffffff, 0xf1f1e1e3, 0xe3ffffff, 0xe3e3e3e3,
+	0xe3ffffff, 0xc7c7c3e3, 0xc3ffffff, 0x838383c3, 0x81ffffff, 0x01010181, 0x00ffffff, 0x00000000,
+	0xc0ffffff, 0xc0c0c0c0, 0xc1ffffff, 0xe0e0c0c0, 0xc1ffffff, 0xf1e1c1c1, 0xc1ffffff, 0xe3e3c1c1,
+	0xc1ffffff, 0xc7c3c1c1, 0xc1ffffff, 0x83838181, 0x81ffffff, 0x01010101, 0x00ffffff, 0x00000000,
+	0xfffffcfc, 0xf8f8f8ff, 0xfffff8f8, 0xf8f8f8ff, 0xfffff1f1, 0xf8f8f8ff, 0xffffe3e3, 0xf8f8f8ff,
+	0xffffc7c7, 0xf8f8f8ff, 0xffff8f8f, 0xf8f8f8ff, 0xffff1f1f, 0xf8f8f8ff, 0xffff3f3f, 0xf8f8f8ff,
+	0xfffcfcfc, 0xf8f8f8ff, 0xfff8f8f8, 0xf8f8f8ff, 0xfff1f1f1, 0xf8f8f8ff, 0xffe3e3e3, 0xf8f8f8ff,
+	0xffc7c7c7, 0xf8f8f8ff, 0xff8f8f8f, 0xf8f8f8ff, 0xff1f1f1f, 0xf8f8f8ff, 0xff3f3f3f, 0xf8f8f8ff,
+	0xfcfcfcff, 0xf8f8f8ff, 0xf8f8f8ff, 0xf8f8f8ff, 0xf1f1f1ff, 0xf8f8f8ff, 0xe3e3e3ff, 0xf8f8f8ff,
+	0xc7c7c7ff, 0xf8f8f8ff, 0x8f8f8fff, 0xf8f8f8ff, 0x1f1f1fff, 0xf8f8f8ff, 0x3f3f3fff, 0xf8f8f8ff,
+	0xfcfcffff, 0xf8f8f8fc, 0xf8f8ffff, 0xf8f8f8f8, 0xf1f1ffff, 0xf8f8f8f1, 0xe3e3ffff, 0xf8f8f8e3,
+	0xc7c7ffff, 0xf8f8f8c7, 0x8f8fffff, 0xf8f8f88f, 0x1f1fffff, 0xf8f8f81f, 0x3f3fffff, 0xf8f8f83f,
+	0xfcffffff, 0xf8f8f8fc, 0xf8ffffff, 0xf8f8f8f8, 0xf1ffffff, 0xf8f8f0f1, 0xe3ffffff, 0xf8f8e0e3,
+	0xc7ffffff, 0xf8f8c0c7, 0x8fffffff, 0xf8f8888f, 0x1fffffff, 0xf8f8181f, 0x3fffffff, 0xf8f8383f,
+	0x00000000, 0x00000000, 0xffffffff, 0xfcf8f8f8, 0xffffffff, 0xfcf0f0f1, 0xffffffff, 0xf8e0e0e3,
+	0xffffffff, 0xf8c0c0c7, 0xffffffff, 0xf888888f, 0xffffffff, 0xf818181f, 0xffffffff, 0xf838383f,
+	0xffffffff, 0xf8f8fcff, 0xffffffff, 0xf8f8f8ff, 0xffffffff, 0xf0f0f0ff, 0xffffffff, 0xe0e0e0ff,
+	0xffffffff, 0xc0c0c0ff, 0xffffffff, 0x888888ff, 0xffffffff, 0x181818ff, 0xffffffff, 0x383838ff,
+	0xffffffff, 0xf8f8fcff, 0xffffffff, 0xf8f8feff, 0xffffffff, 0xf0f0fcff, 0xffffffff, 0xe0e0f8ff,
+	0xffffffff, 0xc0c0f8ff, 0xffffffff, 0x8888f8ff, 0xffffffff, 0x1818f8ff, 0xffffffff, 0x3838f8ff,
+	0xfffffcfc, 0xf0f0f0f0, 0xfffff8f8, 0xf0f0f0f0, 0xfffff1f1, 0xf0f0f0f0, 0xffffe3e3, 0xf0f0f0f0,
+	0xffffc7c7, 0xf0f0f0f0, 0xffff8f8f, 0xf0f0f0f0, 0xffff1f1f, 0xf0f0f0f0, 0xffff3f3f, 0xf0f0f0f0,
+	0xfffcfcfc, 0xf0f0f0f0, 0xfff8f8f8, 0xf0f0f0f0, 0xfff1f1f1, 0xf0f0f0f0, 0xffe3e3e3, 0xf0f0f0f0,
+	0xffc7c7c7, 0xf0f0f0f0, 0xff8f8f8f, 0xf0f0f0f0, 0xff1f1f1f, 0xf0f0f0f0, 0xff3f3f3f, 0xf0f0f0f0,
+	0xfcfcfcff, 0xf0f0f0f0, 0xf8f8f8ff, 0xf0f0f0f0, 0xf1f1f1ff, 0xf0f0f0f0, 0xe3e3e3ff, 0xf0f0f0f0,
+	0xc7c7c7ff, 0xf0f0f0f0, 0x8f8f8fff, 0xf0f0f0f0, 0x1f1f1fff, 0xf0f0f0f0, 0x3f3f3fff, 0xf0f0f0f0,
+	0xfcfcffff, 0xf0f0f0f0, 0xf8f8ffff, 0xf0f0f0f0, 0xf1f1ffff, 0xf0f0f0f0, 0xe3e3ffff, 0xf0f0f0e0,
+	0xc7c7ffff, 0xf0f0f0c0, 0x8f8fffff, 0xf0f0f080, 0x1f1fffff, 0xf0f0f010, 0x3f3fffff, 0xf0f0f030,
+	0xfcffffff, 0xf0f0f0f0, 0xf8ffffff, 0xf0f0f0f8, 0xf1ffffff, 0xf0f0f0f0, 0xe3ffffff, 0xf0f0e0e0,
+	0xc7ffffff, 0xf0f0c0c0, 0x8fffffff, 0xf0f08080, 0x1fffffff, 0xf0f01010, 0x3fffffff, 0xf0f03030,
+	0x00000000, 0x00000000, 0xffffffff, 0xf8f8f8f8, 0xffffffff, 0xf8f0f0f0, 0xffffffff, 0xf0e0e0e0,
+	0xffffffff, 0xf0c0c0c0, 0xffffffff, 0xf0808080, 0xffffffff, 0xf0101010, 0xffffffff, 0xf0303030,
+	0xffffffff, 0xf0f0f0ff, 0xffffffff, 0xf8f8f8ff, 0xffffffff, 0xf0f0f0fc, 0xffffffff, 0xe0e0e0f8,
+	0xffffffff, 0xc0c0c0f0, 0xffffffff, 0x808080f0, 0xffffffff, 0x101010f0, 0xffffffff, 0x303030f0,
+	0xffffffff, 0xf0f0f0f8, 0xffffffff, 0xf8f8fcfc, 0xffffffff, 0xf0f0f8f8, 0xffffffff, 0xe0e0f0f0,
+	0xffffffff, 0xc0c0f0f0, 0xffffffff, 0x8080f0f0, 0xffffffff, 0x1010f0f0, 0xffffffff, 0x3030f0f0,
+	0xfffffcfc, 0xf0f0f0ff, 0xfffff8f8, 0xf0f0f0ff, 0xfffff1f1, 0xf0f0f0ff, 0xffffe3e3, 0xf0f0f0ff,
+	0xffffc7c7, 0xf0f0f0ff, 0xffff8f8f, 0xf0f0f0ff, 0xffff1f1f, 0xf0f0f0ff, 0xffff3f3f, 0xf0f0f0ff,
+	0xfffcfcfc, 0xf0f0f0ff, 0xfff8f8f8, 0xf0f0f0ff, 0xfff1f1f1, 0xf0f0f0ff, 0xffe3e3e3, 0xf0f0f0ff,
+	0xffc7c7c7, 0xf0f0f0ff, 0xff8f8f8f, 0xf0f0f0ff, 0xff1f1f1f, 0xf0f0f0ff, 0xff3f3f3f, 0xf0f0f0ff,
+	0xfcfcfcff, 0xf0f0f0ff, 0xf8f8f8ff, 0xf0f0f0ff, 0xf1f1f1ff, 0xf0f0f0ff, 0xe3e3e3ff, 0xf0f0f0ff,
+	0xc7c7c7ff, 0xf0f0f0ff, 0x8f8f8fff, 0xf0f0f0ff, 0x1f1f1fff, 0xf0f0f0ff, 0x3f3f3fff, 0xf0f0f0ff,
+	0xfcfcffff, 0xf8f8f8fc, 0xf8f8ffff, 0xf8f8f8f8, 0xf1f1ffff, 0xf8f8f8f1, 0xe3e3ffff, 0xf8f8f0e3,
+	0xc7c7ffff, 0xf8f8f0c7, 0x8f8fffff, 0xf0f0f08f, 0x1f1fffff, 0xf0f0f01f, 0x3f3fffff, 0xf0f0f03f,
+	0xfcffffff, 0xfdf8fcfc, 0xf8ffffff, 0xfdf8f8f8, 0xf1ffffff, 0xfdf8f1f1, 0xe3ffffff, 0xfdf8e1e3,
+	0xc7ffffff, 0xf8f8c0c7, 0x8fffffff, 0xf0f0808f, 0x1fffffff, 0xf0f0101f, 0x3fffffff, 0xf0f0303f,
+	0xffffffff, 0xfef8fcfc, 0x00000000, 0x00000000, 0xffffffff, 0xfaf0f1f1, 0xffffffff, 0xfde0e1e3,
+	0xffffffff, 0xf8c0c0c7, 0xffffffff, 0xf080808f, 0xffffffff, 0xf010101f, 0xffffffff, 0xf030303f,
+	0xffffffff, 0xfcf8fcff, 0xffffffff, 0xf8f8f8ff, 0xffffffff, 0xf1f0f1ff, 0xffffffff, 0xe1e0e1ff,
+	0xffffffff, 0xc0c0c0ff, 0xffffffff, 0x808080ff, 0xffffffff, 0x101010ff, 0xffffffff, 0x303030ff,
+	0xffffffff, 0xfcf8fdff, 0xffffffff, 0xf8f8fdff, 0xffffffff, 0xf1f0fdff, 0xffffffff, 0xe0e0f9ff,
+	0xffffffff, 0xc0c0f0ff, 0xffffffff, 0x8080f0ff, 0xffffffff, 0x1010f0ff, 0xffffffff, 0x3030f0ff,
+	0xfffffcfc, 0xe0e0e0e0, 0xfffff8f8, 0xe0e0e0e0, 0xfffff1f1, 0xe0e0e0e0, 0xffffe3e3, 0xe0e0e0e0,
+	0xffffc7c7, 0xe0e0e0e0, 0xffff8f8f, 0xe0e0e0e0, 0xffff1f1f, 0xe0e0e0e0, 0xffff3f3f, 0xe0e0e0e0,
+	0xfffcfcfc, 0xe0e0e0e0, 0xfff8f8f8, 0xe0e0e0e0, 0xfff1f1f1, 0xe0e0e0e0, 0xffe3e3e3, 0xe0e0e0e0,
+	0xffc7c7c7, 0xe0e0e0e0, 0xff8f8f8f, 0xe0e0e0e0, 0xff1f1f1f, 0xe0e0e0e0, 0xff3f3f3f, 0xe0e0e0e0,
+	0xfcfcfcff, 0xe0e0e0e0, 0xf8f8f8ff, 0xe0e0e0e0, 0xf1f1f1ff, 0xe0e0e0e0, 0xe3e3e3ff, 0xe0e0e0e0,
+	0xc7c7c7ff, 0xe0e0e0e0, 0x8f8f8fff, 0xe0e0e0e0, 0x1f1f1fff, 0xe0e0e0e0, 0x3f3f3fff, 0xe0e0e0e0,
+	0xfcfcffff, 0xf0f0f0f0, 0xf8f8ffff, 0xf0f0f0f0, 0xf1f1ffff, 0xf0f0f0f0, 0xe3e3ffff, 0xf0e0e0e0,
+	0xc7c7ffff, 0xf0e0e0c0, 0x8f8fffff, 0xe0e0e080, 0x1f1fffff, 0xe0e0e000, 0x3f3fffff, 0xe0e0e020,
+	0xfcffffff, 0xf8f8f8fc, 0xf8ffffff, 0xf8f8f8f8, 0xf1ffffff, 0xf8f8f0f1, 0xe3ffffff, 0xf8f8e0e0,
+	0xc7ffffff, 0xf0f0c0c0, 0x8fffffff, 0xe0e08080, 0x1fffffff, 0xe0e00000, 0x3fffffff, 0xe0e02020,
+	0xffffffff, 0xfdfcfcfc, 0x00000000, 0x00000000, 0xffffffff, 0xf5f0f1f1, 0xffffffff, 0xf8e0e0e0,
+	0xffffffff, 0xf0c0c0c0, 0xffffffff, 0xe0808080, 0xffffffff, 0xe0000000, 0xffffffff, 0xe0202020,
+	0xffffffff, 0xfcfcfcff, 0xffffffff, 0xf8f8f8ff, 0xffffffff, 0xf0f1f1ff, 0xffffffff, 0xe0e0e0f8,
+	0xffffffff, 0xc0c0c0f0, 0xffffffff, 0x808080e0, 0xffffffff, 0x000000e0, 0xffffffff, 0x202020e0,
+	0xffffffff, 0xfcf8f8f8, 0xffffffff, 0xf8f8f8f8, 0xffffffff, 0xf0f0f8f8, 0xffffffff, 0xe0e0f0f0,
+	0xffffffff, 0xc0c0e0e0, 0xffffffff, 0x8080e0e0, 0xffffffff, 0x0000e0e0, 0xffffffff, 0x2020e0e0,
+	0xfffffcfc, 0xe0e0e0ff, 0xfffff8f8, 0xe0e0e0ff, 0xfffff1f1, 0xe0e0e0ff, 0xffffe3e3, 0xe0e0e0ff,
+	0xffffc7c7, 0xe0e0e0ff, 0xffff8f8f, 0xe0e0e0ff, 0xffff1f1f, 0xe0e0e0ff, 0xffff3f3f, 0xe0e0e0ff,
+	0xfffcfcfc, 0xe0e0e0ff, 0xfff8f8f8, 0xe0e0e0ff, 0xfff1f1f1, 0xe0e0e0ff, 0xffe3e3e3, 0xe0e0e0ff,
+	0xffc7c7c7, 0xe0e0e0ff, 0xff8f8f8f, 0xe0e0e0ff, 0xff1f1f1f, 0xe0e0e0ff, 0xff3f3f3f, 0xe0e0e0ff,
+	0xfcfcfcff, 0xe0e0e0ff, 0xf8f8f8ff, 0xe0e0e0ff, 0xf1f1f1ff, 0xe0e0e0ff, 0xe3e3e3ff, 0xe0e0e0ff,
+	0xc7c7c7ff, 0xe0e0e0ff, 0x8f8f8fff, 0xe0e0e0ff, 0x1f1f1fff, 0xe0e0e0ff, 0x3f3f3fff, 0xe0e0e0ff,
+	0xfcfcffff, 0xf1f1f0fc, 0xf8f8ffff, 0xf1f1f1f8, 0xf1f1ffff, 0xf1f1f1f1, 0xe3e3ffff, 0xf1f1f1e3,
+	0xc7c7ffff, 0xf1f1e1c7, 0x8f8fffff, 0xf1f1e18f, 0x1f1fffff, 0xe0e0e01f, 0x3f3fffff, 0xe0e0e03f,
+	0xfcffffff, 0xfbf1f8fc, 0xf8ffffff, 0xfbf1f8f8, 0xf1ffffff, 0xfbf1f1f1, 0xe3ffffff, 0xfbf1e3e3,
+	0xc7ffffff, 0xfbf1c3c7, 0x8fffffff, 0xf1f1818f, 0x1fffffff, 0xe0e0001f, 0x3fffffff, 0xe0e0203f,
+	0xffffffff, 0xfbf0f8fc, 0xffffffff, 0xfdf0f8f8, 0x00000000, 0x00000000, 0xffffffff, 0xf7e1e3e3,
+	0xffffffff, 0xfbc1c3c7, 0xffffffff, 0xf181818f, 0xffffffff, 0xe000001f, 0xffffffff, 0xe020203f,
+	0xffffffff, 0xf8f0f8ff, 0xffffffff, 0xf8f0f8ff, 0xffffffff, 0xf1f1f1ff, 0xffffffff, 0xe3e1e3ff,
+	0xffffffff, 0xc3c1c3ff, 0xffffffff, 0x818181ff, 0xffffffff, 0x000000ff, 0xffffffff, 0x202020ff,
+	0xffffffff, 0xf8f0f9ff, 0xffffffff, 0xf8f0fbff, 0xffffffff, 0xf1f1fbff, 0xffffffff, 0xe3e1fbff,
+	0xffffffff, 0xc3c1f3ff, 0xffffffff, 0x8181e1ff, 0xffffffff, 0x0000e0ff, 0xffffffff, 0x2020e0ff,
+	0xfffffcfc, 0xc0c0c0c0, 0xfffff8f8, 0xc0c0c0c0, 0xfffff1f1, 0xc0c0c0c0, 0xffffe3e3, 0xc0c0c0c0,
+	0xffffc7c7, 0xc0c0c0c0, 0xffff8f8f, 0xc0c0c0c0, 0xffff1f1f, 0xc0c0c0c0, 0xffff3f3f, 0xc0c0c0c0,
+	0xfffcfcfc, 0xc0c0c0c0, 0xfff8f8f8, 0xc0c0c0c0, 0xfff1f1f1, 0xc0c0c0c0, 0xffe3e3e3, 0xc0c0c0c0,
+	0xffc7c7c7, 0xc0c0c0c0, 0xff8f8f8f, 0xc0c0c0c0, 0xff1f1f1f, 0xc0c0c0c0, 0xff3f3f3f, 0xc0c0c0c0,
+	0xfcfcfcff, 0xc0c0c0c0, 0xf8f8f8ff, 0xc0c0c0c0, 0xf1f1f1ff, 0xc0c0c0c0, 0xe3e3e3ff, 0xc0c0c0c0,
+	0xc7c7c7ff, 0xc0c0c0c0, 0x8f8f8fff, 0xc0c0c0c0, 0x1f1f1fff, 0xc0c0c0c0, 0x3f3f3fff, 0xc0c0c0c0,
+	0xfcfcffff, 0xe0e0e0e0, 0xf8f8ffff, 0xe0e0e0e0, 0xf1f1ffff, 0xe0e0e0e0, 0xe3e3ffff, 0xe0e0e0e0,
+	0xc7c7ffff, 0xe0c0c0c0, 0x8f8fffff, 0xe0c0c080, 0x1f1fffff, 0xc0c0c000, 0x3f3fffff, 0xc0c0c000,
+	0xfcffffff, 0xf1f1f0f0, 0xf8ffffff, 0xf1f1f0f8, 0xf1ffffff, 0xf1f1f1f1, 0xe3ffffff, 0xf1f1e1e3,
+	0xc7ffffff, 0xf1f1c1c1, 0x8fffffff, 0xe0e08080, 0x1fffffff, 0xc0c00000, 0x3fffffff, 0xc0c00000,
+	0xffffffff, 0xf1f0f0f0, 0xffffffff, 0xfaf8f8f8, 0x00000000, 0x00000000, 0xffffffff, 0xebe3e3e3,
+	0xffffffff, 0xf1c1c1c1, 0xffffffff, 0xe0808080, 0xffffffff, 0xc0000000, 0xffffffff, 0xc0000000,
+	0xffffffff, 0xf0f0f0f1, 0xffffffff, 0xf8f8f8ff, 0xffffffff, 0xf1f1f1ff, 0xffffffff, 0xe3e3e3ff,
+	0xffffffff, 0xc1c1c1f1, 0xffffffff, 0x808080e0, 0xffffffff, 0x000000c0, 0xffffffff, 0x000000c0,
+	0xffffffff, 0xf0f0f0f0, 0xffffffff, 0xf8f0f1f1, 0xffffffff, 0xf1f1f1f1, 0xffffffff, 0xe3e1f1f1,
+	0xffffffff, 0xc1c1e1e1, 0xffffffff, 0x8080c0c0, 0xffffffff, 0x0000c0c0, 0xffffffff, 0x0000c0c0,
+	0xfffffcfc, 0xc1c1c1ff, 0xfffff8f8, 0xc1c1c1ff, 0xfffff1f1, 0xc1c1c1ff, 0xffffe3e3, 0xc1c1c1ff,
+	0xffffc7c7, 0xc1c1c1ff, 0xffff8f8f, 0xc1c1c1ff, 0xffff1f1f, 0xc1c1c1ff, 0xffff3f3f, 0xc1c1c1ff,
+	0xfffcfcfc, 0xc1c1c1ff, 0xfff8f8f8, 0xc1c1c1ff, 0xfff1f1f1, 0xc1c1c1ff, 0xffe3e3e3, 0xc1c1c1ff,
+	0xffc7c7c7, 0xc1c1c1ff, 0xff8f8f8f, 0xc1c1c1ff, 0xff1f1f1f, 0xc1c1c1ff, 0xff3f3f3f, 0xc1c1c1ff,
+	0xfcfcfcff, 0xc1c1c1ff, 0xf8f8f8ff, 0xc1c1c1ff, 0xf1f1f1ff, 0xc1c1c1ff, 0xe3e3e3ff, 0xc1c1c1ff,
+	0xc7c7c7ff, 0xc1c1c1ff, 0x8f8f8fff, 0xc1c1c1ff, 0x1f1f1fff, 0xc1c1c1ff, 0x3f3f3fff, 0xc1c1c1ff,
+	0xfcfcffff, 0xe3e3e1fc, 0xf8f8ffff, 0xe3e3e1f8, 0xf1f1ffff, 0xe3e3e3f1, 0xe3e3ffff, 0xe3e3e3e3,
+	0xc7c7ffff, 0xe3e3e3c7, 0x8f8fffff, 0xe3e3c38f, 0x1f1fffff, 0xe3e3c31f, 0x3f3fffff, 0xc1c1c13f,
+	0xfcffffff, 0xe3e3e0fc, 0xf8ffffff, 0xf7e3f0f8, 0xf1ffffff, 0xf7e3f1f1, 0xe3ffffff, 0xf7e3e3e3,
+	0xc7ffffff, 0xf7e3c7c7, 0x8fffffff, 0xf7e3878f, 0x1fffffff, 0xe3e3031f, 0x3fffffff, 0xc1c1013f,
+	0xffffffff, 0xe3e0e0fc, 0xffffffff, 0xf7e0f0f8, 0xffffffff, 0xfbe1f1f1, 0x00000000, 0x00000000,
+	0xffffffff, 0xefc3c7c7, 0xffffffff, 0xf783878f, 0xffffffff, 0xe303031f, 0xffffffff, 0xc101013f,
+	0xffffffff, 0xe0e0e0ff, 0xffffffff, 0xf0e0f0ff, 0xffffffff, 0xf1e1f1ff, 0xffffffff, 0xe3e3e3ff,
+	0xffffffff, 0xc7c3c7ff, 0xffffffff, 0x878387ff, 0xffffffff, 0x030303ff, 0xffffffff, 0x010101ff,
+	0xffffffff, 0xe0e0e1ff, 0xffffffff, 0xf0e0f3ff, 0xffffffff, 0xf1e1f7ff, 0xffffffff, 0xe3e3f7ff,
+	0xffffffff, 0xc7c3f7ff, 0xffffffff, 0x8783e7ff, 0xffffffff, 0x0303c3ff, 0xffffffff, 0x0101c1ff,
+	0xfffffcfc, 0x80808080, 0xfffff8f8, 0x80808080, 0xfffff1f1, 0x80808080, 0xffffe3e3, 0x80808080,
+	0xffffc7c7, 0x80808080, 0xffff8f8f, 0x80808080, 0xffff1f1f, 0x80808080, 0xffff3f3f, 0x80808080,
+	0xfffcfcfc, 0x80808080, 0xfff8f8f8, 0x80808080, 0xfff1f1f1, 0x80808080, 0xffe3e3e3, 0x80808080,
+	0xffc7c7c7, 0x80808080, 0xff8f8f8f, 0x80808080, 0xff1f1f1f, 0x80808080, 0xff3f3f3f, 0x80808080,
+	0xfcfcfcff, 0x80808080, 0xf8f8f8ff, 0x80808080, 0xf1f1f1ff, 0x80808080, 0xe3e3e3ff, 0x80808080,
+	0xc7c7c7ff, 0x80808080, 0x8f8f8fff, 0x80808080, 0x1f1f1fff, 0x80808080, 0x3f3f3fff, 0x80808080,
+	0xfcfcffff, 0xc1c0c0c0, 0xf8f8ffff, 0xc1c0c0c0, 0xf1f1ffff, 0xc1c1c1c1, 0xe3e3ffff, 0xc1c1c1c1,
+	0xc7c7ffff, 0xc1c1c1c1, 0x8f8fffff, 0xc1818181, 0x1f1fffff, 0xc1818101, 0x3f3fffff, 0x80808000,
+	0xfcffffff, 0xc1c1c0c0, 0xf8ffffff, 0xe3e3e0e0, 0xf1ffffff, 0xe3e3e1f1, 0xe3ffffff, 0xe3e3e3e3,
+	0xc7ffffff, 0xe3e3c3c7, 0x8fffffff, 0xe3e38383, 0x1fffffff, 0xc1c10101, 0x3fffffff, 0x80800000,
+	0xffffffff, 0xc1c0c0c0, 0xffffffff, 0xe3e0e0e0, 0xffffffff, 0xf5f1f1f1, 0x00000000, 0x00000000,
+	0xffffffff, 0xd7c7c7c7, 0xffffffff, 0xe3838383, 0xffffffff, 0xc1010101, 0xffffffff, 0x80000000,
+	0xffffffff, 0xc0c0c0c1, 0xffffffff, 0xe0e0e0e3, 0xffffffff, 0xf1f1f1ff, 0xffffffff, 0xe3e3e3ff,
+	0xffffffff, 0xc7c7c7ff, 0xffffffff, 0x838383e3, 0xffffffff, 0x010101c1, 0xffffffff, 0x00000080,
+	0xffffffff, 0xc0c0c0c0, 0xffffffff, 0xe0e0e1e1, 0xffffffff, 0xf1e1e3e3, 0xffffffff, 0xe3e3e3e3,
+	0xffffffff, 0xc7c3e3e3, 0xffffffff, 0x8383c3c3, 0xffffffff, 0x01018181, 0xffffffff, 0x00008080,
+	0xfffffcfc, 0xfcfcffff, 0xfffff8f8, 0xfcfcffff, 0xfffff1f1, 0xfcfcffff, 0xffffe3e3, 0xfcfcffff,
+	0xffffc7c7, 0xfcfcffff, 0xffff8f8f, 0xfcfcffff, 0xffff1f1f, 0xfcfcffff, 0xffff3f3f, 0xfcfcffff,
+	0xfffcfcfc, 0xfcfcffff, 0xfff8f8f8, 0xfcfcffff, 0xfff1f1f1, 0xfcfcffff, 0xffe3e3e3, 0xfcfcffff,
+	0xffc7c7c7, 0xfcfcffff, 0xff8f8f8f, 0xfcfcffff, 0xff1f1f1f, 0xfcfcffff, 0xff3f3f3f, 0xfcfcffff,
+	0xfcfcfcff, 0xfcfcffff, 0xf8f8f8ff, 0xfcfcffff, 0xf1f1f1ff, 0xfcfcffff, 0xe3e3e3ff, 0xfcfcffff,
+	0xc7c7c7ff, 0xfcfcffff, 0x8f8f8fff, 0xfcfcffff, 0x1f1f1fff, 0xfcfcffff, 0x3f3f3fff, 0xfcfcffff,
+	0xfcfcffff, 0xfcfcfffc, 0xf8f8ffff, 0xfcfcfff8, 0xf1f1ffff, 0xfcfcfff1, 0xe3e3ffff, 0xfcfcffe3,
+	0xc7c7ffff, 0xfcfcffc7, 0x8f8fffff, 0xfcfcff8f, 0x1f1fffff, 0xfcfcff1f, 0x3f3fffff, 0xfcfcff3f,
+	0xfcffffff, 0xfcfcfcfc, 0xf8ffffff, 0xfcfcf8f8, 0xf1ffffff, 0xfcfcf1f1, 0xe3ffffff, 0xfcfce3e3,
+	0xc7ffffff, 0xfcfcc7c7, 0x8fffffff, 0xfcfc8f8f, 0x1fffffff, 0xfcfc1f1f, 0x3fffffff, 0xfcfc3f3f,
+	0xffffffff, 0xfcfcfcfc, 0xffffffff, 0xfcf8f8f8, 0xffffffff, 0xfcf0f1f1, 0xffffffff, 0xfce0e3e3,
+	0xffffffff, 0xfcc4c7c7, 0xffffffff, 0xfc8c8f8f, 0xffffffff, 0xfc1c1f1f, 0xffffffff, 0xfc3c3f3f,
+	0x00000000, 0x00000000, 0xffffffff, 0xf8f8f8ff, 0xffffffff, 0xf0f0f1ff, 0xffffffff, 0xe0e0e3ff,
+	0xffffffff, 0xc4c4c7ff, 0xffffffff, 0x8c8c8fff, 0xffffffff, 0x1c1c1fff, 0xffffffff, 0x3c3c3fff,
+	0xffffffff, 0xf8f8ffff, 0xffffffff, 0xf8f8ffff, 0xffffffff, 0xf0f0ffff, 0xffffffff, 0xe0e0ffff,
+	0xffffffff, 0xc4c4ffff, 0xffffffff, 0x8c8cffff, 0xffffffff, 0x1c1cffff, 0xffffffff, 0x3c3cffff,
+	0xfffffcfc, 0xf8f8f8ff, 0xfffff8f8, 0xf8f8f8ff, 0xfffff1f1, 0xf8f8f8ff, 0xffffe3e3, 0xf8f8f8ff,
+	0xffffc7c7, 0xf8f8f8ff, 0xffff8f8f, 0xf8f8f8ff, 0xffff1f1f, 0xf8f8f8ff, 0xffff3f3f, 0xf8f8f8ff,
+	0xfffcfcfc, 0xf8f8f8ff, 0xfff8f8f8, 0xf8f8f8ff, 0xfff1f1f1, 0xf8f8f8ff, 0xffe3e3e3, 0xf8f8f8ff,
+	0xffc7c7c7, 0xf8f8f8ff, 0xff8f8f8f, 0xf8f8f8ff, 0xff1f1f1f, 0xf8f8f8ff, 0xff3f3f3f, 0xf8f8f8ff,
+	0xfcfcfcff, 0xf8f8f8ff, 0xf8f8f8ff, 0xf8f8f8ff, 0xf1f1f1ff, 0xf8f8f8ff, 0xe3e3e3ff, 0xf8f8f8ff,
+	0xc7c7c7ff, 0xf8f8f8ff, 0x8f8f8fff, 0xf8f8f8ff, 0x1f1f1fff, 0xf8f8f8ff, 0x3f3f3fff, 0xf8f8f8ff,
+	0xfcfcffff, 0xf8f8f8fc, 0xf8f8ffff, 0xf8f8f8f8, 0xf1f1ffff, 0xf8f8f8f1, 0xe3e3ffff, 0xf8f8f8e3,
+	0xc7c7ffff, 0xf8f8f8c7, 0x8f8fffff, 0xf8f8f88f, 0x1f1fffff, 0xf8f8f81f, 0x3f3fffff, 0xf8f8f83f,
+	0xfcffffff, 0xf8f8f8fc, 0xf8ffffff, 0xf8f8f8f8, 0xf1ffffff, 0xf8f8f0f1, 0xe3ffffff, 0xf8f8e0e3,
+	0xc7ffffff, 0xf8f8c0c7, 0x8fffffff, 0xf8f8888f, 0x1fffffff, 0xf8f8181f, 0x3fffffff, 0xf8f8383f,
+	0xffffffff, 0xfcfcfcfc, 0xffffffff, 0xfcf8f8f8, 0xffffffff, 0xfcf0f0f1, 0xffffffff, 0xf8e0e0e3,
+	0xffffffff, 0xf8c0c0c7, 0xffffffff, 0xf888888f, 0xffffffff, 0xf818181f, 0xffffffff, 0xf838383f,
+	0x00000000, 0x00000000, 0xffffffff, 0xf8f8f8ff, 0xffffffff, 0xf0f0f0ff, 0xffffffff, 0xe0e0e0ff,
+	0xffffffff, 0xc0c0c0ff, 0xffffffff, 0x888888ff, 0xffffffff, 0x181818ff, 0xffffffff, 0x383838ff,
+	0xffffffff, 0xf0f0f1ff, 0xffffffff, 0xf8f8ffff, 0xffffffff, 0xf0f0fcff, 0xffffffff, 0xe0e0f8ff,
+	0xffffffff, 0xc0c0f8ff, 0xffffffff, 0x8888f8ff, 0xffffffff, 0x1818f8ff, 0xffffffff, 0x3838f8ff,
+	0xfffffcfc, 0xf8f8ffff, 0xfffff8f8, 0xf8f8ffff, 0xfffff1f1, 0xf8f8ffff, 0xffffe3e3, 0xf8f8ffff,
+	0xffffc7c7, 0xf8f8ffff, 0xffff8f8f, 0xf8f8ffff, 0xffff1f1f, 0xf8f8ffff, 0xffff3f3f, 0xf8f8ffff,
+	0xfffcfcfc, 0xf8f8ffff, 0xfff8f8f8, 0xf8f8ffff, 0xfff1f1f1, 0xf8f8ffff, 0xffe3e3e3, 0xf8f8ffff,
+	0xffc7c7c7, 0xf8f8ffff, 0xff8f8f8f, 0xf8f8ffff, 0xff1f1f1f, 0xf8f8ffff, 0xff3f3f3f, 0xf8f8ffff,
+	0xfcfcfcff, 0xf8f8ffff, 0xf8f8f8ff, 0xf8f8ffff, 0xf1f1f1ff, 0xf8f8ffff, 0xe3e3e3ff, 0xf8f8ffff,
+	0xc7c7c7ff, 0xf8f8ffff, 0x8f8f8fff, 0xf8f8ffff, 0x1f1f1fff, 0xf8f8ffff, 0x3f3f3fff, 0xf8f8ffff,
+	0xfcfcffff, 0xf8f8fffc, 0xf8f8ffff, 0xf8f8fff8, 0xf1f1ffff, 0xf8f8fff1, 0xe3e3ffff, 0xf8f8ffe3,
+	0xc7c7ffff, 0xf8f8ffc7, 0x8f8fffff, 0xf8f8ff8f, 0x1f1fffff, 0xf8f8ff1f, 0x3f3fffff, 0xf8f8ff3f,
+	0xfcffffff, 0xfaf8fcfc, 0xf8ffffff, 0xfaf8f8f8, 0xf1ffffff, 0xfaf8f1f1, 0xe3ffffff, 0xfaf8e3e3,
+	0xc7ffffff, 0xf8f8c7c7, 0x8fffffff, 0xf8f88f8f, 0x1fffffff, 0xf8f81f1f, 0x3fffffff, 0xf8f83f3f,
+	0xffffffff, 0xf8fcfcfc, 0xffffffff, 0xfaf8f8f8, 0xffffffff, 0xf8f1f1f1, 0xffffffff, 0xfae1e3e3,
+	0xffffffff, 0xf8c0c7c7, 0xffffffff, 0xf8888f8f, 0xffffffff, 0xf8181f1f, 0xffffffff, 0xf8383f3f,
+	0xffffffff, 0xf8fcfcff, 0x00000000, 0x00000000, 0xffffffff, 0xf0f1f1ff, 0xffffffff, 0xe2e1e3ff,
+	0xffffffff, 0xc0c0c7ff, 0xffffffff, 0x88888fff, 0xffffffff, 0x18181fff, 0xffffffff, 0x38383fff,
+	0xffffffff, 0xf8fcffff, 0xffffffff, 0xf8f8ffff, 0xffffffff, 0xf0f1ffff, 0xffffffff, 0xe0e1ffff,
+	0xffffffff, 0xc0c0ffff, 0xffffffff, 0x8888ffff, 0xffffffff, 0x1818ffff, 0xffffffff, 0x3838ffff,
+	0xfffffcfc, 0xf0f0f0ff, 0xfffff8f8, 0xf0f0f0ff, 0xfffff1f1, 0xf0f0f0ff, 0xffffe3e3, 0xf0f0f0ff,
+	0xffffc7c7, 0xf0f0f0ff, 0xffff8f8f, 0xf0f0f0ff, 0xffff1f1f, 0xf0f0f0ff, 0xffff3f3f, 0xf0f0f0ff,
+	0xfffcfcfc, 0xf0f0f0ff, 0xfff8f8f8, 0xf0f0f0ff, 0xfff1f1f1, 0xf0f0f0ff, 0xffe3e3e3, 0xf0f0f0ff,
+	0xffc7c7c7, 0xf0f0f0ff, 0xff8f8f8f, 0xf0f0f0ff, 0xff1f1f1f, 0xf0f0f0ff, 0xff3f3f3f, 0xf0f0f0ff,
+	0xfcfcfcff, 0xf0f0f0ff, 0xf8f8f8ff, 0xf0f0f0ff, 0xf1f1f1ff, 0xf0f0f0ff, 0xe3e3e3ff, 0xf0f0f0ff,
+	0xc7c7c7ff, 0xf0f0f0ff, 0x8f8f8fff, 0xf0f0f0ff, 0x1f1f1fff, 0xf0f0f0ff, 0x3f3f3fff, 0xf0f0f0ff,
+	0xfcfcffff, 0xf0f0f0fc, 0xf8f8ffff, 0xf0f0f0f8, 0xf1f1ffff, 0xf0f0f0f1, 0xe3e3ffff, 0xf0f0f0e3,
+	0xc7c7ffff, 0xf0f0f0c7, 0x8f8fffff, 0xf0f0f08f, 0x1f1fffff, 0xf0f0f01f, 0x3f3fffff, 0xf0f0f03f,
+	0xfcffffff, 0xf0f0f0fc, 0xf8ffffff, 0xf0f0f0f8, 0xf1ffffff, 0xf0f0f0f1, 0xe3ffffff, 0xf0f0e0e3,
+	0xc7ffffff, 0xf0f0c0c7, 0x8fffffff, 0xf0f0808f, 0x1fffffff, 0xf0f0101f, 0x3fffffff, 0xf0f0303f,
+	0xffffffff, 0xfaf8fcfc, 0xffffffff, 0xfdf8f8f8, 0xffffffff, 0xfaf0f1f1, 0xffffffff, 0xf8e0e0e3,
+	0xffffffff, 0xf0c0c0c7, 0xffffffff, 0xf080808f, 0xffffffff, 0xf010101f, 0xffffffff, 0xf030303f,
+	0xffffffff, 0xfcfcfcff, 0x00000000, 0x00000000, 0xffffffff, 0xf1f1f1ff, 0xffffffff, 0xe0e0e0ff,
+	0xffffffff, 0xc0c0c0ff, 0xffffffff, 0x808080ff, 0xffffffff, 0x101010ff, 0xffffffff, 0x303030ff,
+	0xffffffff, 0xfcfcffff, 0xffffffff, 0xf8f8ffff, 0xffffffff, 0xf1f1ffff, 0xffffffff, 0xe0e0f8ff,
+	0xffffffff, 0xc0c0f0ff, 0xffffffff, 0x8080f0ff, 0xffffffff, 0x1010f0ff, 0xffffffff, 0x3030f0ff,
+	0xfffffcfc, 0xf1f1ffff, 0xfffff8f8, 0xf1f1ffff, 0xfffff1f1, 0xf1f1ffff, 0xffffe3e3, 0xf1f1ffff,
+	0xffffc7c7, 0xf1f1ffff, 0xffff8f8f, 0xf1f1ffff, 0xffff1f1f, 0xf1f1ffff, 0xffff3f3f, 0xf1f1ffff,
+	0xfffcfcfc, 0xf1f1ffff, 0xfff8f8f8, 0xf1f1ffff, 0xfff1f1f1, 0xf1f1ffff, 0xffe3e3e3, 0xf1f1ffff,
+	0xffc7c7c7, 0xf1f1ffff, 0xff8f8f8f, 0xf1f1ffff, 0xff1f1f1f, 0xf1f1ffff, 0xff3f3f3f, 0xf1f1ffff,
+	0xfcfcfcff, 0xf1f1ffff, 0xf8f8f8ff, 0xf1f1ffff, 0xf1f1f1ff, 0xf1f1ffff, 0xe3e3e3ff, 0xf1f1ffff,
+	0xc7c7c7ff, 0xf1f1ffff, 0x8f8f8fff, 0xf1f1ffff, 0x1f1f1fff, 0xf1f1ffff, 0x3f3f3fff, 0xf1f1ffff,
+	0xfcfcffff, 0xf1f1fffc, 0xf8f8ffff, 0xf1f1fff8, 0xf1f1ffff, 0xf1f1fff1, 0xe3e3ffff, 0xf1f1ffe3,
+	0xc7c7ffff, 0xf1f1ffc7, 0x8f8fffff, 0xf1f1ff8f, 0x1f1fffff, 0xf1f1ff1f, 0x3f3fffff, 0xf1f1ff3f,
+	0xfcffffff, 0xf5f1fcfc, 0xf8ffffff, 0xf5f1f8f8, 0xf1ffffff, 0xf5f1f1f1, 0xe3ffffff, 0xf5f1e3e3,
+	0xc7ffffff, 0xf5f1c7c7, 0x8fffffff, 0xf1f18f8f, 0x1fffffff, 0xf1f11f1f, 0x3fffffff, 0xf1f13f3f,
+	0xffffffff, 0xf5f8fcfc, 0xffffffff, 0xf1f8f8f8, 0xffffffff, 0xf5f1f1f1, 0xffffffff, 0xf1e3e3e3,
+	0xffffffff, 0xf5c3c7c7, 0xffffffff, 0xf1818f8f, 0xffffffff, 0xf1111f1f, 0xffffffff, 0xf1313f3f,
+	0xffffffff, 0xf4f8fcff, 0xffffffff, 0xf0f8f8ff, 0x00000000, 0x00000000, 0xffffffff, 0xe1e3e3ff,
+	0xffffffff, 0xc5c3c7ff, 0xffffffff, 0x81818fff, 0xffffffff, 0x11111fff, 0xffffffff, 0x31313fff,
+	0xffffffff, 0xf0f8ffff, 0xffffffff, 0xf0f8ffff, 0xffffffff, 0xf1f1ffff, 0xffffffff, 0xe1e3ffff,
+	0xffffffff, 0xc1c3ffff, 0xffffffff, 0x8181ffff, 0xffffffff, 0x1111ffff, 0xffffffff, 0x3131ffff,
+	0xfffffcfc, 0xe0e0e0ff, 0xfffff8f8, 0xe0e0e0ff, 0xfffff1f1, 0xe0e0e0ff, 0xffffe3e3, 0xe0e0e0ff,
+	0xffffc7c7, 0xe0e0e0ff, 0xffff8f8f, 0xe0e0e0ff, 0xffff1f1f, 0xe0e0e0ff, 0xffff3f3f, 0xe0e0e0ff,
+	0xfffcfcfc, 0xe0e0e0ff, 0xfff8f8f8, 0xe0e0e0ff, 0xfff1f1f1, 0xe0e0e0ff, 0xffe3e3e3, 0xe0e0e0ff,
+	0xffc7c7c7, 0xe0e0e0ff, 0xff8f8f8f, 0xe0e0e0ff, 0xff1f1f1f, 0xe0e0e0ff, 0xff3f3f3f, 0xe0e0e0ff,
+	0xfcfcfcff, 0xe0e0e0ff, 0xf8f8f8ff, 0xe0e0e0ff, 0xf1f1f1ff, 0xe0e0e0ff, 0xe3e3e3ff, 0xe0e0e0ff,
+	0xc7c7c7ff, 0xe0e0e0ff, 0x8f8f8fff, 0xe0e0e0ff, 0x1f1f1fff, 0xe0e0e0ff, 0x3f3f3fff, 0xe0e0e0ff,
+	0xfcfcffff, 0xe0e0e0fc, 0xf8f8ffff, 0xe0e0e0f8, 0xf1f1ffff, 0xe0e0e0f1, 0xe3e3ffff, 0xe0e0e0e3,
+	0xc7c7ffff, 0xe0e0e0c7, 0x8f8fffff, 0xe0e0e08f, 0x1f1fffff, 0xe0e0e01f, 0x3f3fffff, 0xe0e0e03f,
+	0xfcffffff, 0xe0e0e0fc, 0xf8ffffff, 0xe0e0e0f8, 0xf1ffffff, 0xe0e0e0f1, 0xe3ffffff, 0xe0e0e0e3,
+	0xc7ffffff, 0xe0e0c0c7, 0x8fffffff, 0xe0e0808f, 0x1fffffff, 0xe0e0001f, 0x3fffffff, 0xe0e0203f,
+	0xffffffff, 0xf0f0f0fc, 0xffffffff, 0xf4f0f8f8, 0xffffffff, 0xfbf1f1f1, 0xffffffff, 0xf5e1e3e3,
+	0xffffffff, 0xf1c1c1c7, 0xffffffff, 0xe080808f, 0xffffffff, 0xe000001f, 0xffffffff, 0xe020203f,
+	0xffffffff, 0xf0f0f0ff, 0xffffffff, 0xf8f8f8ff, 0x00000000, 0x00000000, 0xffffffff, 0xe3e3e3ff,
+	0xffffffff, 0xc1c1c1ff, 0xffffffff, 0x808080ff, 0xffffffff, 0x000000ff, 0xffffffff, 0x202020ff,
+	0xffffffff, 0xf0f0f1ff, 0xffffffff, 0xf8f8ffff, 0xffffffff, 0xf1f1ffff, 0xffffffff, 0xe3e3ffff,
+	0xffffffff, 0xc1c1f1ff, 0xffffffff, 0x8080e0ff, 0xffffffff, 0x0000e0ff, 0xffffffff, 0x2020e0ff,
+	0xfffffcfc, 0xe3e3ffff, 0xfffff8f8, 0xe3e3ffff, 0xfffff1f1, 0xe3e3ffff, 0xffffe3e3, 0xe3e3ffff,
+	0xffffc7c7, 0xe3e3ffff, 0xffff8f8f, 0xe3e3ffff, 0xffff1f1f, 0xe3e3ffff, 0xffff3f3f, 0xe3e3ffff,
+	0xfffcfcfc, 0xe3e3ffff, 0xfff8f8f8, 0xe3e3ffff, 0xfff1f1f1, 0xe3e3ffff, 0xffe3e3e3, 0xe3e3ffff,
+	0xffc7c7c7, 0xe3e3ffff, 0xff8f8f8f, 0xe3e3ffff, 0xff1f1f1f, 0xe3e3ffff, 0xff3f3f3f, 0xe3e3ffff,
+	0xfcfcfcff, 0xe3e3ffff, 0xf8f8f8ff, 0xe3e3ffff, 0xf1f1f1ff, 0xe3e3ffff, 0xe3e3e3ff, 0xe3e3ffff,
+	0xc7c7c7ff, 0xe3e3ffff, 0x8f8f8fff, 0xe3e3ffff, 0x1f1f1fff, 0xe3e3ffff, 0x3f3f3fff, 0xe3e3ffff,
+	0xfcfcffff, 0xe3e3fffc, 0xf8f8ffff, 0xe3e3fff8, 0xf1f1ffff, 0xe3e3fff1, 0xe3e3ffff, 0xe3e3ffe3,
+	0xc7c7ffff, 0xe3e3ffc7, 0x8f8fffff, 0xe3e3ff8f, 0x1f1fffff, 0xe3e3ff1f, 0x3f3fffff, 0xe3e3ff3f,
+	0xfcffffff, 0xe3e3fcfc, 0xf8ffffff, 0xebe3f8f8, 0xf1ffffff, 0xebe3f1f1, 0xe3ffffff, 0xebe3e3e3,
+	0xc7ffffff, 0xebe3c7c7, 0x8fffffff, 0xebe38f8f, 0x1fffffff, 0xe3e31f1f, 0x3fffffff, 0xe3e33f3f,
+	0xffffffff, 0xe3e0fcfc, 0xffffffff, 0xebf0f8f8, 0xffffffff, 0xe3f1f1f1, 0xffffffff, 0xebe3e3e3,
+	0xffffffff, 0xe3c7c7c7, 0xffffffff, 0xeb878f8f, 0xffffffff, 0xe3031f1f, 0xffffffff, 0xe3233f3f,
+	0xffffffff, 0xe0e0fcff, 0xffffffff, 0xe8f0f8ff, 0xffffffff, 0xe1f1f1ff, 0x00000000, 0x00000000,
+	0xffffffff, 0xc3c7c7ff, 0xffffffff, 0x8b878fff, 0xffffffff, 0x03031fff, 0xffffffff, 0x23233fff,
+	0xffffffff, 0xe0e0ffff, 0xffffffff, 0xe0f0ffff, 0xffffffff, 0xe1f1ffff, 0xffffffff, 0xe3e3ffff,
+	0xffffffff, 0xc3c7ffff, 0xffffffff, 0x8387ffff, 0xffffffff, 0x0303ffff, 0xffffffff, 0x2323ffff,
+	0xfffffcfc, 0xc1c1c1ff, 0xfffff8f8, 0xc1c1c1ff, 0xfffff1f1, 0xc1c1c1ff, 0xffffe3e3, 0xc1c1c1ff,
+	0xffffc7c7, 0xc1c1c1ff, 0xffff8f8f, 0xc1c1c1ff, 0xffff1f1f, 0xc1c1c1ff, 0xffff3f3f, 0xc1c1c1ff,
+	0xfffcfcfc, 0xc1c1c1ff, 0xfff8f8f8, 0xc1c1c1ff, 0xfff1f1f1, 0xc1c1c1ff, 0xffe3e3e3, 0xc1c1c1ff,
+	0xffc7c7c7, 0xc1c1c1ff, 0xff8f8f8f, 0xc1c1c1ff, 0xff1f1f1f, 0xc1c1c1ff, 0xff3f3f3f, 0xc1c1c1ff,
+	0xfcfcfcff, 0xc1c1c1ff, 0xf8f8f8ff, 0xc1c1c1ff, 0xf1f1f1ff, 0xc1c1c1ff, 0xe3e3e3ff, 0xc1c1c1ff,
+	0xc7c7c7ff, 0xc1c1c1ff, 0x8f8f8fff, 0xc1c1c1ff, 0x1f1f1fff, 0xc1c1c1ff, 0x3f3f3fff, 0xc1c1c1ff,
+	0xfcfcffff, 0xc1c1c1fc, 0xf8f8ffff, 0xc1c1c1f8, 0xf1f1ffff, 0xc1c1c1f1, 0xe3e3ffff, 0xc1c1c1e3,
+	0xc7c7ffff, 0xc1c1c1c7, 0x8f8fffff, 0xc1c1c18f, 0x1f1fffff, 0xc1c1c11f, 0x3f3fffff, 0xc1c1c13f,
+	0xfcffffff, 0xc1c1c0fc, 0xf8ffffff, 0xc1c1c0f8, 0xf1ffffff, 0xc1c1c1f1, 0xe3ffffff, 0xc1c1c1e3,
+	0xc7ffffff, 0xc1c1c1c7, 0x8fffffff, 0xc1c1818f, 0x1fffffff, 0xc1c1011f, 0x3fffffff, 0xc1c1013f,
+	0xffffffff, 0xc1c0c0fc, 0xffffffff, 0xe3e0e0f8, 0xffffffff, 0xebe1f1f1, 0xffffffff, 0xf7e3e3e3,
+	0xffffffff, 0xebc3c7c7, 0xffffffff, 0xe383838f, 0xffffffff, 0xc101011f, 0xffffffff, 0xc101013f,
+	0xffffffff, 0xc0c0c0ff, 0xffffffff, 0xe0e0e0ff, 0xffffffff, 0xf1f1f1ff, 0x00000000, 0x00000000,
+	0xffffffff, 0xc7c7c7ff, 0xffffffff, 0x838383ff, 0xffffffff, 0x010101ff, 0xffffffff, 0x010101ff,
+	0xffffffff, 0xc0c0c1ff, 0xffffffff, 0xe0e0e3ff, 0xffffffff, 0xf1f1ffff, 0xffffffff, 0xe3e3ffff,
+	0xffffffff, 0xc7c7ffff, 0xffffffff, 0x8383e3ff, 0xffffffff, 0x0101c1ff, 0xffffffff, 0x0101c1ff,
+}
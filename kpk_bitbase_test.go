@@ -0,0 +1,143 @@
+package dragontoothmg
+
+import (
+	"flag"
+	"math/bits"
+	"testing"
+)
+
+var updateKPKBitbase = flag.Bool("update-kpk-bitbase", false, "regenerate kpk_bitbase.go")
+
+// Results of the classification, as flags
+const (
+	kpkInvalid = 0
+	kpkUnknown = 1
+	kpkDraw    = 2
+	kpkWin     = 4
+)
+
+func kpkPawnAttacks(psq uint8) uint64 {
+	attacks := uint64(0)
+	if psq&7 > 0 {
+		attacks |= 1 << (psq + 7)
+	}
+	if psq&7 < 7 {
+		attacks |= 1 << (psq + 9)
+	}
+	return attacks
+}
+
+func kpkDistance(a, b uint8) int {
+	fileDist := int(a&7) - int(b&7)
+	rankDist := int(a>>3) - int(b>>3)
+	return max(fileDist, -fileDist, rankDist, -rankDist)
+}
+
+// Classifies the positions that are decided without looking at the moves.
+func kpkInitialResult(wtm bool, wksq, bksq, psq uint8) uint8 {
+	switch {
+	case kpkDistance(wksq, bksq) <= 1 || wksq == psq || bksq == psq ||
+		(wtm && kpkPawnAttacks(psq)&(1<<bksq) != 0):
+		return kpkInvalid
+	// The pawn promotes, and the queen can't be captured
+	case wtm && psq>>3 == 6 && wksq != psq+8 &&
+		(kpkDistance(bksq, psq+8) > 1 || kingMasks[wksq]&(1<<(psq+8)) != 0):
+		return kpkWin
+	// Stalemate, or the pawn is captured
+	case !wtm && (kingMasks[bksq]&^(kingMasks[wksq]|kpkPawnAttacks(psq)) == 0 ||
+		kingMasks[bksq]&^kingMasks[wksq]&(1<<psq) != 0):
+		return kpkDraw
+	}
+	return kpkUnknown
+}
+
+// Classifies a position from the results of its moves. Moves to invalid
+// positions (such as a king moving next to the other) are ignored.
+func kpkMovesResult(db []uint8, wtm bool, wksq, bksq, psq uint8) uint8 {
+	r := uint8(kpkInvalid)
+	if wtm {
+		for b := kingMasks[wksq]; b != 0; b &= b - 1 {
+			r |= db[kpkIndex(false, uint8(bits.TrailingZeros64(b)), bksq, psq)]
+		}
+		if psq>>3 < 6 {
+			r |= db[kpkIndex(false, wksq, bksq, psq+8)]
+		}
+		if psq>>3 == 1 && psq+8 != wksq && psq+8 != bksq {
+			r |= db[kpkIndex(false, wksq, bksq, psq+16)]
+		}
+		if r&kpkWin != 0 {
+			return kpkWin
+		} else if r&kpkUnknown != 0 {
+			return kpkUnknown
+		}
+		return kpkDraw
+	}
+	for b := kingMasks[bksq]; b != 0; b &= b - 1 {
+		r |= db[kpkIndex(true, wksq, uint8(bits.TrailingZeros64(b)), psq)]
+	}
+	if r&kpkDraw != 0 {
+		return kpkDraw
+	} else if r&kpkUnknown != 0 {
+		return kpkUnknown
+	}
+	return kpkWin
+}
+
+// Computes the bitbase by retrograde analysis, as stored in kpk_bitbase.go.
+func generateKPKBitbase() (bitbase [kpkSize / 32]uint32) {
+	db := make([]uint8, kpkSize)
+	forEachKPK := func(f func(idx int, wtm bool, wksq, bksq, psq uint8)) {
+		for psq := uint8(8); psq < 56; psq++ {
+			if psq&7 > 3 {
+				continue
+			}
+			for wksq := uint8(0); wksq < 64; wksq++ {
+				for bksq := uint8(0); bksq < 64; bksq++ {
+					for _, wtm := range [2]bool{true, false} {
+						f(kpkIndex(wtm, wksq, bksq, psq), wtm, wksq, bksq, psq)
+					}
+				}
+			}
+		}
+	}
+	forEachKPK(func(idx int, wtm bool, wksq, bksq, psq uint8) {
+		db[idx] = kpkInitialResult(wtm, wksq, bksq, psq)
+	})
+	for changed := true; changed; {
+		changed = false
+		forEachKPK(func(idx int, wtm bool, wksq, bksq, psq uint8) {
+			if db[idx] == kpkUnknown {
+				db[idx] = kpkMovesResult(db, wtm, wksq, bksq, psq)
+				changed = changed || db[idx] != kpkUnknown
+			}
+		})
+	}
+	for idx, r := range db {
+		if r == kpkWin {
+			bitbase[idx/32] |= 1 << (idx % 32)
+		}
+	}
+	return
+}
+
+// Proves that the generated bitbase equals the one computed by retrograde analysis.
+// With the -update-kpk-bitbase flag, as run by go generate, writes it to kpk_bitbase.go.
+func TestKPKBitbase(t *testing.T) {
+	bitbase := generateKPKBitbase()
+	if *updateKPKBitbase {
+		values := make([]uint64, len(bitbase))
+		for i, word := range bitbase {
+			values[i] = uint64(word)
+		}
+		err := writeGeneratedFile("kpk_bitbase.go", "go test -run TestKPKBitbase -update-kpk-bitbase", []generatedArray{
+			{"The king and pawn versus king bitbase, indexed by kpkIndex.", "kpkBitbase", "uint32", "%#08x", 8, values},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	if kpkBitbase != bitbase {
+		t.Fatal("kpk_bitbase.go is out of date, run go generate")
+	}
+}
@@ -0,0 +1,103 @@
+package dragontoothmg_test
+
+// In an external test package, since the tablebase generator imports dragontoothmg.
+
+import (
+	"testing"
+
+	"github.com/IlikeChooros/dragontoothmg"
+	"github.com/IlikeChooros/dragontoothmg/tablebase"
+)
+
+// Builds a board with a white king, a black king, and a pawn.
+func kpkBoard(wksq, bksq, psq int, whitePawn, wtm bool) dragontoothmg.Board {
+	b := dragontoothmg.Board{Wtomove: wtm}
+	b.White.Kings, b.Black.Kings = 1<<wksq, 1<<bksq
+	if whitePawn {
+		b.White.Pawns = 1 << psq
+	} else {
+		b.Black.Pawns = 1 << psq
+	}
+	b.White.All = b.White.Kings | b.White.Pawns
+	b.Black.All = b.Black.Kings | b.Black.Pawns
+	return b
+}
+
+// Compares the bitbase with the tablebase generator, on every legal position.
+func TestProbeKPK(t *testing.T) {
+	table, err := tablebase.NewGenerator().Generate("KPvK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checked := 0
+	for psq := 8; psq < 56; psq++ {
+		for wksq := 0; wksq < 64; wksq++ {
+			for bksq := 0; bksq < 64; bksq++ {
+				if wksq == bksq || wksq == psq || bksq == psq {
+					continue
+				}
+				for _, whitePawn := range []bool{true, false} {
+					for _, wtm := range []bool{true, false} {
+						b := kpkBoard(wksq, bksq, psq, whitePawn, wtm)
+						result, err := table.Probe(&b)
+						if err != nil {
+							t.Fatal(err)
+						}
+						// Only legal positions have a stored value
+						if !legal(&b) {
+							continue
+						}
+						win, ok := dragontoothmg.ProbeKPK(&b)
+						if !ok {
+							t.Fatal("Expected a KPK position:", b.ToFen())
+						}
+						expected := result.Outcome == tablebase.Win
+						if wtm != whitePawn {
+							expected = result.Outcome == tablebase.Loss
+						}
+						if win != expected {
+							t.Error("KPK bitbase gives win =", win, "in", b.ToFen(), "but the tablebase gives", result.Outcome)
+						}
+						checked++
+					}
+				}
+			}
+		}
+	}
+	if checked == 0 {
+		t.Error("No position checked")
+	}
+}
+
+// Returns whether the side that just moved is not in check.
+func legal(b *dragontoothmg.Board) bool {
+	b.Wtomove = !b.Wtomove
+	inCheck := b.OurKingInCheck()
+	b.Wtomove = !b.Wtomove
+	return !inCheck
+}
+
+func TestProbeKPKMaterial(t *testing.T) {
+	fens := []string{
+		dragontoothmg.Startpos,
+		"8/8/8/8/8/4k3/8/4K3 w - - 0 1",
+		"8/8/8/4p3/8/4k3/4P3/4K3 w - - 0 1",
+		"8/8/8/8/8/4k3/4PP2/4K3 w - - 0 1",
+		"8/8/8/8/8/4k3/4P3/4KN2 w - - 0 1",
+		"k7/8/8/8/8/8/8/K6P w - - 0 1", // pawns on the first and last ranks
+		"k6P/8/8/8/8/8/8/K7 w - - 0 1",
+		"k7/8/8/8/8/8/8/K6p b - - 0 1",
+		"k6p/8/8/8/8/8/8/K7 b - - 0 1",
+		"k7/8/8/8/8/8/P7/8 w - - 0 1", // missing or extra kings
+		"8/8/8/8/8/8/P7/K7 w - - 0 1",
+		"k7/8/8/8/8/8/8/8 w - - 0 1",
+		"k7/8/8/8/8/8/P7/K6K w - - 0 1",
+		"k6k/8/8/8/8/8/P7/K7 w - - 0 1",
+	}
+	for _, fen := range fens {
+		b := dragontoothmg.ParseFen(fen)
+		if _, ok := dragontoothmg.ProbeKPK(&b); ok {
+			t.Error("Expected no KPK result for", fen)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package dragontoothmg
+
+// Single-move legality checks and pseudo-legal move generation. A search validates
+// moves that didn't come from the move generator, such as transposition table and
+// killer moves, at every node. Instead of generating the whole move list, these
+// checks look at the one move: the moving piece, its reachable squares from the
+// magic tables, and whether our king is attacked once it is made. Engines can also
+// generate pseudo-legal moves, and check them lazily the same way. Only standard
+// chess is checked directly; variant boards fall back to the move generator.
+
+import "math/bits"
+
+// Returns whether the move can be made by the side to move, ignoring whether it
+// leaves our king in check: we own the moving piece, the target square is empty or
+// holds an enemy piece, and the piece can reach it. Castling requires the castling
+// right and an empty path, and a pawn reaching the last rank must promote.
+// Moves are expected in the form the move generator produces them.
+// On variant boards, this is the same as IsLegalMove.
+func (b *Board) IsPseudoLegal(m Move) bool {
+	if b.rules != nil {
+		return b.IsLegal(m)
+	}
+	if m.IsDrop() {
+		return false
+	}
+	var ourPieces, oppPieces *Bitboards
+	if b.Wtomove {
+		ourPieces, oppPieces = &(b.White), &(b.Black)
+	} else {
+		ourPieces, oppPieces = &(b.Black), &(b.White)
+	}
+	from, to := m.From(), m.To()
+	fromBitboard, toBitboard := uint64(1)<<from, uint64(1)<<to
+	if ourPieces.All&fromBitboard == 0 || ourPieces.All&toBitboard != 0 {
+		return false
+	}
+	allPieces := ourPieces.All | oppPieces.All
+	piece, _ := b.PieceAt(Square(from))
+	if piece != Pawn && m.Promote() != Nothing {
+		return false
+	}
+
+	var targets uint64
+	switch piece {
+	case Pawn:
+		targets = b.pawnTargets(fromBitboard, allPieces, oppPieces.All)
+		// Pawns must promote on the last rank, to a knight, bishop, rook or queen
+		promote := m.Promote()
+		if toBitboard&(onlyRank[0]|onlyRank[7]) != 0 {
+			if promote < Knight || promote > Queen {
+				return false
+			}
+		} else if promote != Nothing {
+			return false
+		}
+	case Knight:
+		targets = knightMasks[from]
+	case Bishop:
+		targets = CalculateBishopMoveBitboard(from, allPieces)
+	case Rook:
+		targets = CalculateRookMoveBitboard(from, allPieces)
+	case Queen:
+		targets = CalculateBishopMoveBitboard(from, allPieces) | CalculateRookMoveBitboard(from, allPieces)
+	case King:
+		targets = kingMasks[from]
+		if delta := int(to) - int(from); delta == 2 || delta == -2 {
+			return b.castlingPathClear(from, to)
+		}
+	default: // the bitboards are inconsistent
+		return false
+	}
+	return targets&toBitboard != 0
+}
+
+// Returns whether the move is legal in the current position, without generating
+// all the moves. Faster than IsLegal, and meant for moves of unknown origin, such
+// as transposition table and killer moves.
+func (b *Board) IsLegalMove(m Move) bool {
+	if b.rules != nil {
+		return b.IsLegal(m)
+	}
+	return b.IsPseudoLegal(m) && !b.leavesKingInCheck(m)
+}
+
+// Generates the moves of the side to move without checking that they leave our king
+// safe: pinned pieces move freely, the king may step into check, evasions are not
+// required, and castling only needs the right and an empty path. Use
+// LeavesKingInCheck to filter the moves lazily, such as after ordering them.
+// On variant boards, the legal moves are generated.
+func (b *Board) GeneratePseudoLegalMoves() []Move {
+	if b.rules != nil {
+		return b.rules.GenerateMoves(b, Nothing)
+	}
+	moves := make([]Move, 0, kDefaultMoveListLength)
+	b.pseudoLegalMoves(&moves, Nothing)
+	b.castlingCandidates(&moves)
+	return moves
+}
+
+// Returns whether a pseudo-legal move (see GeneratePseudoLegalMoves) leaves the king
+// of the side to move in check, or castles out of or through check. A move passing
+// this check is legal. On variant boards, returns whether the move is not legal.
+func (b *Board) LeavesKingInCheck(m Move) bool {
+	if b.rules != nil {
+		return !b.IsLegal(m)
+	}
+	return b.leavesKingInCheck(m)
+}
+
+// Returns the squares a pawn of the side to move can go to: pushes to empty
+// squares, and captures of enemy pieces or en passant.
+func (b *Board) pawnTargets(fromBitboard uint64, allPieces uint64, oppPieces uint64) uint64 {
+	notHFile := uint64(0x7F7F7F7F7F7F7F7F)
+	notAFile := uint64(0xFEFEFEFEFEFEFEFE)
+	if b.enpassant > 0 {
+		oppPieces |= uint64(1) << b.enpassant
+	}
+	var pushes, captures uint64
+	if b.Wtomove {
+		pushes = fromBitboard << 8 &^ allPieces
+		pushes |= pushes << 8 & onlyRank[3] &^ allPieces
+		captures = (fromBitboard<<9&notAFile | fromBitboard<<7&notHFile) & oppPieces
+	} else {
+		pushes = fromBitboard >> 8 &^ allPieces
+		pushes |= pushes >> 8 & onlyRank[4] &^ allPieces
+		captures = (fromBitboard>>7&notAFile | fromBitboard>>9&notHFile) & oppPieces
+	}
+	return pushes | captures
+}
+
+// Returns whether the side to move may castle from the king square to the target
+// square by its castling rights, with an empty path between the king and the rook.
+func (b *Board) castlingPathClear(from uint8, to uint8) bool {
+	allPieces := b.White.All | b.Black.All
+	if b.Wtomove && from == 4 {
+		switch to {
+		case 6:
+			return b.WhiteCanCastleKingside() && allPieces&((1<<5)|(1<<6)) == 0
+		case 2:
+			return b.WhiteCanCastleQueenside() && allPieces&((1<<3)|(1<<2)|(1<<1)) == 0
+		}
+	} else if !b.Wtomove && from == 60 {
+		switch to {
+		case 62:
+			return b.BlackCanCastleKingside() && allPieces&((1<<61)|(1<<62)) == 0
+		case 58:
+			return b.BlackCanCastleQueenside() && allPieces&((1<<57)|(1<<58)|(1<<59)) == 0
+		}
+	}
+	return false
+}
+
+// Returns whether a pseudo-legal move leaves the king of the side to move in check.
+// Castling is also refused out of check, and through an attacked square.
+// The board is only read: the attacks are computed on the occupancy after the move,
+// which covers pins, check evasions and en passant captures exposing the king.
+func (b *Board) leavesKingInCheck(m Move) bool {
+	var ourPieces, oppPieces *Bitboards
+	var epDelta int8 = -8 // add this to the e.p. square to find the captured pawn
+	if b.Wtomove {
+		ourPieces, oppPieces = &(b.White), &(b.Black)
+	} else {
+		ourPieces, oppPieces = &(b.Black), &(b.White)
+		epDelta = 8
+	}
+	if ourPieces.Kings == 0 {
+		return false
+	}
+	from, to := m.From(), m.To()
+	fromBitboard, toBitboard := uint64(1)<<from, uint64(1)<<to
+	kingBitboard := ourPieces.Kings
+	occupied := (ourPieces.All|oppPieces.All)&^fromBitboard | toBitboard
+	// a captured piece no longer attacks
+	attackers := oppPieces.All &^ toBitboard
+
+	if fromBitboard&ourPieces.Kings != 0 {
+		if delta := int(to) - int(from); delta == 2 || delta == -2 {
+			transit := uint8(int(from) + delta/2)
+			return b.UnderDirectAttack(b.Wtomove, from) || b.UnderDirectAttack(b.Wtomove, transit) ||
+				b.UnderDirectAttack(b.Wtomove, to)
+		}
+		kingBitboard = toBitboard
+	} else if to == b.enpassant && b.enpassant != 0 && fromBitboard&ourPieces.Pawns != 0 {
+		capturedBitboard := uint64(1) << uint8(int8(to)+epDelta)
+		occupied &^= capturedBitboard
+		attackers &^= capturedBitboard
+	}
+	kingLocation := uint8(bits.TrailingZeros64(kingBitboard))
+	return squareAttackedBy(kingLocation, occupied, oppPieces, attackers, b.Wtomove)
+}
+
+// Returns whether a square is attacked by the opponent pieces in the attackers mask,
+// given the occupied squares. byBlack tells the color of the opponent.
+func squareAttackedBy(square uint8, occupied uint64, oppPieces *Bitboards, attackers uint64, byBlack bool) bool {
+	squareBitboard := uint64(1) << square
+	var pawnAttackers uint64
+	if byBlack {
+		pawnAttackers = squareBitboard<<7&^onlyFile[7] | squareBitboard<<9&^onlyFile[0]
+	} else {
+		pawnAttackers = squareBitboard>>7&^onlyFile[0] | squareBitboard>>9&^onlyFile[7]
+	}
+	if pawnAttackers&oppPieces.Pawns&attackers != 0 ||
+		knightMasks[square]&oppPieces.Knights&attackers != 0 ||
+		kingMasks[square]&oppPieces.Kings&attackers != 0 {
+		return true
+	}
+	diagAttackers := (oppPieces.Bishops | oppPieces.Queens) & attackers
+	if diagAttackers != 0 && CalculateBishopMoveBitboard(square, occupied)&diagAttackers != 0 {
+		return true
+	}
+	orthoAttackers := (oppPieces.Rooks | oppPieces.Queens) & attackers
+	return orthoAttackers != 0 && CalculateRookMoveBitboard(square, occupied)&orthoAttackers != 0
+}
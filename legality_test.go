@@ -0,0 +1,166 @@
+package dragontoothmg
+
+import (
+	"slices"
+	"testing"
+)
+
+// Compares IsPseudoLegal and IsLegalMove against the move generators on every
+// position of the perft trees, for all the moves of our pieces.
+func TestIsLegalMove(t *testing.T) {
+	tests := []struct {
+		fen   string
+		depth int
+	}{
+		{Startpos, 3},
+		{"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0", 2},
+		{"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 0", 3},
+		{"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1", 2},
+		{"rnbq1k1r/pp1Pbppp/2p5/8/2B5/8/PPP1NnPP/RNBQK2R w KQ - 1 8", 2},
+		{"n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1", 3},
+		// en passant exposing the king on the rank, and on a diagonal
+		{"8/8/8/KPp4r/8/8/8/6k1 w - c6 0 1", 3},
+		{"8/8/8/8/k2Pp2Q/8/8/3K4 b - d3 0 1", 3},
+		{"8/8/1b6/8/3pP3/8/8/5K1k b - e3 0 1", 3},
+		// en passant capturing the checking pawn
+		{"8/8/8/2k5/3Pp3/8/8/4K3 b - d3 0 1", 3},
+		// castling out of and through check
+		{"r3k2r/8/8/8/8/8/8/R3K1qR w KQkq - 0 1", 2},
+		{"r3k2r/8/8/8/1b6/8/8/R3K2R w KQkq - 0 1", 2},
+	}
+	for _, test := range tests {
+		b := ParseFen(test.fen)
+		checkLegalityTree(t, &b, test.depth)
+	}
+}
+
+func checkLegalityTree(t *testing.T, b *Board, depth int) {
+	legal := b.GenerateLegalMoves()
+	var pseudo []Move
+	b.pseudoLegalMoves(&pseudo, Nothing)
+	b.castlingCandidates(&pseudo)
+	fen := b.ToFen()
+
+	for from := 0; from < 64; from++ {
+		for to := 0; to < 64; to++ {
+			promotions := []Piece{Nothing, Queen}
+			if to < 8 || to >= 56 {
+				promotions = []Piece{Nothing, Pawn, Knight, Bishop, Rook, Queen, King}
+			}
+			for _, promote := range promotions {
+				var m Move
+				m.Setfrom(Square(from)).Setto(Square(to)).Setpromote(promote)
+				if b.IsPseudoLegal(m) != slices.Contains(pseudo, m) {
+					t.Fatal("IsPseudoLegal of", m.String(), "is", b.IsPseudoLegal(m), "in", fen)
+				}
+				if b.IsLegalMove(m) != slices.Contains(legal, m) {
+					t.Fatal("IsLegalMove of", m.String(), "is", b.IsLegalMove(m), "in", fen)
+				}
+			}
+		}
+	}
+	if b.ToFen() != fen || !mailboxInSync(b) {
+		t.Fatal("Legality checks changed the board", fen)
+	}
+
+	if depth <= 1 {
+		return
+	}
+	for _, m := range legal {
+		b.Make(m)
+		checkLegalityTree(t, b, depth-1)
+		b.Undo()
+	}
+}
+
+func TestIsLegalMoveVariant(t *testing.T) {
+	b := NewBoardVariant(VariantCrazyhouse)
+	b.Make(parseMove("e2e4"))
+	b.Make(parseMove("d7d5"))
+	b.Make(parseMove("e4d5"))
+	drop := parseMove("P@e6")
+	if b.IsLegalMove(drop) || !b.IsLegal(b.GenerateLegalMoves()[0]) {
+		t.Error("Wrong legality of variant moves")
+	}
+	b.Make(parseMove("d8d5"))
+	if !b.IsLegalMove(drop) || !b.IsPseudoLegal(drop) {
+		t.Error("Drop from the pocket is not legal")
+	}
+	standard := ParseFen(Startpos)
+	if standard.IsLegalMove(drop) || standard.IsPseudoLegal(drop) {
+		t.Error("Drop is legal in standard chess")
+	}
+}
+
+// Perft counting the pseudo-legal moves that don't leave the king in check.
+func perftPseudoLegal(b *Board, n int) int64 {
+	if n <= 0 {
+		return 1
+	}
+	var count int64 = 0
+	for _, move := range b.GeneratePseudoLegalMoves() {
+		if b.LeavesKingInCheck(move) {
+			continue
+		}
+		b.Make(move)
+		count += perftPseudoLegal(b, n-1)
+		b.Undo()
+	}
+	return count
+}
+
+func TestPseudoLegalPerft(t *testing.T) {
+	tests := []struct {
+		fen   string
+		depth int
+		nodes int64
+	}{
+		{Startpos, 4, 197281},
+		{"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0", 3, 97862},
+		{"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 0", 5, 674624},
+		{"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1", 4, 422333},
+		{"rnbq1k1r/pp1Pbppp/2p5/8/2B5/8/PPP1NnPP/RNBQK2R w KQ - 1 8", 3, 62379},
+		{"n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1", 4, 182838},
+	}
+	for _, test := range tests {
+		b := ParseFen(test.fen)
+		if nodes := perftPseudoLegal(&b, test.depth); nodes != test.nodes {
+			t.Error("Pseudo-legal perft", test.depth, "of", test.fen, "expected", test.nodes, "got", nodes)
+		}
+	}
+}
+
+// Filtering the pseudo-legal moves gives the legal moves, in any position of the tree.
+func TestPseudoLegalFiltering(t *testing.T) {
+	b := ParseFen("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0")
+	var walk func(depth int)
+	walk = func(depth int) {
+		legal := b.GenerateLegalMoves()
+		var filtered []Move
+		for _, m := range b.GeneratePseudoLegalMoves() {
+			if !b.LeavesKingInCheck(m) {
+				filtered = append(filtered, m)
+			}
+		}
+		slices.Sort(legal)
+		slices.Sort(filtered)
+		if !slices.Equal(legal, filtered) {
+			t.Fatal("Filtered pseudo-legal moves differ from the legal moves in", b.ToFen())
+		}
+		if depth <= 1 {
+			return
+		}
+		for _, m := range legal {
+			b.Make(m)
+			walk(depth - 1)
+			b.Undo()
+		}
+	}
+	walk(3)
+
+	v := NewBoardVariant(VariantAtomic)
+	if len(v.GeneratePseudoLegalMoves()) != 20 || v.LeavesKingInCheck(parseMove("e2e4")) ||
+		!v.LeavesKingInCheck(parseMove("e1e2")) {
+		t.Error("Wrong pseudo-legal moves on a variant board")
+	}
+}
@@ -0,0 +1,118 @@
+package dragontoothmg
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"slices"
+	"testing"
+)
+
+var updateMagicTables = flag.Bool("update-magic-tables", false, "regenerate magic_tables.go")
+
+// Computes the magic moves database, as stored in magic_tables.go: the attacks of
+// the rooks, then of the bishops, for every subset of the blocker mask of each square.
+func computeMagicTables() (table []uint64, rookOffsets [64]uint32, bishopOffsets [64]uint32) {
+	sliders := []struct {
+		offsets           *[64]uint32
+		sizes             *[64]uint64
+		masks             *[64]uint64
+		numbers           *[64]uint64
+		shifts            *[64]uint64
+		movesFromBlockers func(Square, uint64) uint64
+	}{
+		{&rookOffsets, &magicDbSizeRook, &magicRookBlockerMasks, &magicNumberRook, &magicRookShifts,
+			rookMovesFromBlockers},
+		{&bishopOffsets, &magicDbSizeBishop, &magicBishopBlockerMasks, &magicNumberBishop, &magicBishopShifts,
+			bishopMovesFromBlockers},
+	}
+	for _, slider := range sliders {
+		for square := 0; square < 64; square++ {
+			offset := len(table)
+			slider.offsets[square] = uint32(offset)
+			table = append(table, make([]uint64, slider.sizes[square])...)
+			mask := slider.masks[square]
+			for blockers := uint64(0); ; blockers = (blockers - mask) & mask {
+				dbindex := (blockers * slider.numbers[square]) >> slider.shifts[square]
+				table[offset+int(dbindex)] = slider.movesFromBlockers(Square(square), blockers)
+				if blockers == mask {
+					break
+				}
+			}
+		}
+	}
+	return
+}
+
+// Proves that the generated tables equal the ones computed at runtime. With the
+// -update-magic-tables flag, as run by go generate, writes them to magic_tables.go.
+func TestMagicTables(t *testing.T) {
+	table, rookOffsets, bishopOffsets := computeMagicTables()
+	if *updateMagicTables {
+		if err := writeMagicTables("magic_tables.go", table, rookOffsets, bishopOffsets); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	if !slices.Equal(magicMoves[:], table) || magicRookOffsets != rookOffsets || magicBishopOffsets != bishopOffsets {
+		t.Fatal("magic_tables.go is out of date, run go generate")
+	}
+}
+
+func writeMagicTables(filename string, table []uint64, rookOffsets [64]uint32, bishopOffsets [64]uint32) error {
+	widen := func(offsets [64]uint32) []uint64 {
+		values := make([]uint64, len(offsets))
+		for i, offset := range offsets {
+			values[i] = uint64(offset)
+		}
+		return values
+	}
+	return writeGeneratedFile(filename, "go test -run TestMagicTables -update-magic-tables", []generatedArray{
+		{"The index of the first attacks of a rook on each square in magicMoves.",
+			"magicRookOffsets", "uint32", "%d", 8, widen(rookOffsets)},
+		{"The index of the first attacks of a bishop on each square in magicMoves.",
+			"magicBishopOffsets", "uint32", "%d", 8, widen(bishopOffsets)},
+		{"The magic moves database: the attacks of the rooks, then of the bishops, for each\n" +
+			"// square, at the index given by its magic number and the blockers.",
+			"magicMoves", "uint64", "%#016x", 4, table},
+	})
+}
+
+// An array written to a generated file.
+type generatedArray struct {
+	comment  string
+	name     string
+	elemType string
+	verb     string // the format of each value
+	perLine  int
+	values   []uint64
+}
+
+// Writes a generated Go file declaring the arrays, noting the command generating it.
+func writeGeneratedFile(filename string, command string, arrays []generatedArray) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by %q; DO NOT EDIT.\n\n", command)
+	buf.WriteString("package dragontoothmg\n\n")
+	for _, array := range arrays {
+		fmt.Fprintf(&buf, "// %s\nvar %s = [%d]%s{\n", array.comment, array.name, len(array.values), array.elemType)
+		for i, value := range array.values {
+			if i%array.perLine == 0 {
+				buf.WriteString("\t")
+			}
+			fmt.Fprintf(&buf, array.verb+",", value)
+			if i%array.perLine == array.perLine-1 || i == len(array.values)-1 {
+				buf.WriteString("\n")
+			} else {
+				buf.WriteString(" ")
+			}
+		}
+		buf.WriteString("}\n\n")
+	}
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, source, 0o644)
+}
@@ -9,6 +9,7 @@ package dragontoothmg
 import (
 	//"fmt"
 	"math/bits"
+	"slices"
 )
 
 // The main API entrypoint. Generates all legal moves for a given board.
@@ -43,8 +44,72 @@ func (b *Board) getMoveListLength(piece Piece) int {
 
 // Generates moves for given piece type
 func (b *Board) GenerateMovesForPiece(piece Piece) []Move {
+	if b.rules != nil {
+		return b.rules.GenerateMoves(b, piece)
+	}
 	moves := make([]Move, 0, b.getMoveListLength(piece))
+	b.standardMoves(&moves, piece)
+	return moves
+}
+
+// Generates the legal moves of the piece on the given square, if it belongs to the
+// side to move. Drops are not included. Under the standard rules, only the moves of
+// that piece are generated; the variants generate all the moves and filter them.
+func (b *Board) GenerateMovesFrom(sq Square) []Move {
+	if b.rules != nil {
+		return slices.DeleteFunc(b.GenerateLegalMoves(), func(m Move) bool {
+			return m.IsDrop() || m.From() != uint8(sq)
+		})
+	}
+	piece, _ := b.PieceAt(sq)
+	moves := make([]Move, 0, b.getMoveListLength(piece))
+	b.standardMovesMasked(&moves, Nothing, uint64(1)<<sq, everything)
+	return moves
+}
+
+// Generates the legal moves landing on the given square, including drops. Under the
+// standard rules, only the moves to that square are generated; the variants generate
+// all the moves and filter them.
+func (b *Board) GenerateMovesTo(sq Square) []Move {
+	if b.rules != nil {
+		return slices.DeleteFunc(b.GenerateLegalMoves(), func(m Move) bool {
+			return m.To() != uint8(sq)
+		})
+	}
+	moves := make([]Move, 0, 16)
+	b.standardMovesMasked(&moves, Nothing, everything, uint64(1)<<sq)
+	return moves
+}
+
+// Returns the squares each piece of the side to move can go to, indexed by the
+// square of the piece. Drops are not included. Under the standard rules, the squares
+// are mostly read from the target bitboards, without generating the moves; the
+// variants generate all the moves.
+func (b *Board) LegalDestinations() [64]uint64 {
+	var destinations [64]uint64
+	if b.rules != nil {
+		for _, m := range b.GenerateLegalMoves() {
+			if !m.IsDrop() {
+				destinations[m.From()] |= uint64(1) << m.To()
+			}
+		}
+		return destinations
+	}
+	b.standardDestinations(&destinations)
+	return destinations
+}
 
+// Generates the legal moves for given piece type under the standard chess rules.
+// Returns the squares other moves, such as drops, may go to without leaving the king
+// in check: any square when not in check, the blocking squares in single check.
+func (b *Board) standardMoves(moveList *[]Move, piece Piece) uint64 {
+	return b.standardMovesMasked(moveList, piece, everything, everything)
+}
+
+// Generates the legal moves for given piece type under the standard chess rules, of
+// the pieces on the origins squares, going to the squares in targets.
+// Returns the same squares as standardMoves, regardless of the masks.
+func (b *Board) standardMovesMasked(moveList *[]Move, piece Piece, origins uint64, targets uint64) uint64 {
 	var kingLocation uint8
 	var ourPiecesPtr *Bitboards
 	if b.Wtomove { // assumes only one king
@@ -54,86 +119,338 @@ func (b *Board) GenerateMovesForPiece(piece Piece) []Move {
 		kingLocation = uint8(bits.TrailingZeros64(b.Black.Kings))
 		ourPiecesPtr = &(b.Black)
 	}
+	if piece != Nothing {
+		origins &= *ourPiecesPtr.pieceBitboard(piece)
+	}
+	kingMovable := origins&ourPiecesPtr.Kings != 0
+	// en passant captures are only restricted by the targets, see pawnCaptures
+	var epTarget uint64
+	if b.enpassant > 0 {
+		epTarget = targets & (uint64(1) << b.enpassant)
+	}
 
 	// If in check, only king moves are possible
 	kingAttackers, blockDest := b.CountAttacks(b.Wtomove, kingLocation, 2)
 	if kingAttackers >= 2 {
-		if piece == Nothing || piece == King {
-			b.kingPushes(&moves, ourPiecesPtr)
+		if kingMovable {
+			b.kingPushes(moveList, ourPiecesPtr, targets)
 		}
-		return moves
+		return 0
 	}
 
 	if kingAttackers == 1 {
-		pinnedPieces := b.generatePinnedMoves(&moves, blockDest)
-		nonpinnedPieces := ^pinnedPieces
+		allowDest := blockDest & targets
+		pinnedPieces := b.generatePinnedMoves(moveList, origins, allowDest)
+		nonpinnedPieces := ^pinnedPieces & origins
 
 		if piece != Nothing {
 			switch piece {
 			case Pawn:
-				b.pawnPushes(&moves, nonpinnedPieces, blockDest)
-				b.pawnCaptures(&moves, nonpinnedPieces, blockDest)
+				b.pawnPushes(moveList, nonpinnedPieces, allowDest)
+				b.pawnCaptures(moveList, nonpinnedPieces, allowDest|epTarget)
 			case Knight:
-				b.knightMoves(&moves, nonpinnedPieces, blockDest)
+				b.knightMoves(moveList, nonpinnedPieces, allowDest)
 			case Rook:
-				b.rookMoves(&moves, nonpinnedPieces, blockDest)
+				b.rookMoves(moveList, nonpinnedPieces, allowDest)
 			case Bishop:
-				b.bishopMoves(&moves, nonpinnedPieces, blockDest)
+				b.bishopMoves(moveList, nonpinnedPieces, allowDest)
 			case Queen:
-				b.queenMoves(&moves, nonpinnedPieces, blockDest)
+				b.queenMoves(moveList, nonpinnedPieces, allowDest)
 			case King:
-				b.kingPushes(&moves, ourPiecesPtr)
+				if kingMovable {
+					b.kingPushes(moveList, ourPiecesPtr, targets)
+				}
 			}
 		} else {
-			b.pawnPushes(&moves, nonpinnedPieces, blockDest)
-			b.pawnCaptures(&moves, nonpinnedPieces, blockDest)
-			b.knightMoves(&moves, nonpinnedPieces, blockDest)
-			b.rookMoves(&moves, nonpinnedPieces, blockDest)
-			b.bishopMoves(&moves, nonpinnedPieces, blockDest)
-			b.queenMoves(&moves, nonpinnedPieces, blockDest)
-			b.kingPushes(&moves, ourPiecesPtr)
+			b.pawnPushes(moveList, nonpinnedPieces, allowDest)
+			b.pawnCaptures(moveList, nonpinnedPieces, allowDest|epTarget)
+			b.knightMoves(moveList, nonpinnedPieces, allowDest)
+			b.rookMoves(moveList, nonpinnedPieces, allowDest)
+			b.bishopMoves(moveList, nonpinnedPieces, allowDest)
+			b.queenMoves(moveList, nonpinnedPieces, allowDest)
+			if kingMovable {
+				b.kingPushes(moveList, ourPiecesPtr, targets)
+			}
 		}
-
-		return moves
+		return blockDest
 	}
 
-	pinnedPieces := b.generatePinnedMoves(&moves, everything)
-	nonpinnedPieces := ^pinnedPieces
+	pinnedPieces := b.generatePinnedMoves(moveList, origins, targets)
+	nonpinnedPieces := ^pinnedPieces & origins
 
 	if piece != Nothing {
 		switch piece {
 		case Pawn:
-			b.pawnPushes(&moves, nonpinnedPieces, everything)
-			b.pawnCaptures(&moves, nonpinnedPieces, everything)
+			b.pawnPushes(moveList, nonpinnedPieces, targets)
+			b.pawnCaptures(moveList, nonpinnedPieces, targets)
 		case Knight:
-			b.knightMoves(&moves, nonpinnedPieces, everything)
+			b.knightMoves(moveList, nonpinnedPieces, targets)
 		case Rook:
-			b.rookMoves(&moves, nonpinnedPieces, everything)
+			b.rookMoves(moveList, nonpinnedPieces, targets)
 		case Bishop:
-			b.bishopMoves(&moves, nonpinnedPieces, everything)
+			b.bishopMoves(moveList, nonpinnedPieces, targets)
 		case Queen:
-			b.queenMoves(&moves, nonpinnedPieces, everything)
+			b.queenMoves(moveList, nonpinnedPieces, targets)
 		case King:
-			b.kingMoves(&moves)
+			if kingMovable {
+				b.kingMoves(moveList, targets)
+			}
 		}
 	} else {
 		// Finally, compute ordinary moves, ignoring absolutely pinned pieces on the board.
-		b.pawnPushes(&moves, nonpinnedPieces, everything)
-		b.pawnCaptures(&moves, nonpinnedPieces, everything)
-		b.knightMoves(&moves, nonpinnedPieces, everything)
-		b.rookMoves(&moves, nonpinnedPieces, everything)
-		b.bishopMoves(&moves, nonpinnedPieces, everything)
-		b.queenMoves(&moves, nonpinnedPieces, everything)
-		b.kingMoves(&moves)
+		b.pawnPushes(moveList, nonpinnedPieces, targets)
+		b.pawnCaptures(moveList, nonpinnedPieces, targets)
+		b.knightMoves(moveList, nonpinnedPieces, targets)
+		b.rookMoves(moveList, nonpinnedPieces, targets)
+		b.bishopMoves(moveList, nonpinnedPieces, targets)
+		b.queenMoves(moveList, nonpinnedPieces, targets)
+		if kingMovable {
+			b.kingMoves(moveList, targets)
+		}
 	}
+	return everything
+}
 
-	return moves
+// Returns the number of legal moves, without generating them when possible.
+// Faster than counting the moves from GenerateLegalMoves().
+func (b *Board) CountLegalMoves() int {
+	if b.rules != nil {
+		return len(b.rules.GenerateMoves(b, Nothing))
+	}
+	return b.standardMoveCount()
+}
+
+// Counts the legal moves under the standard chess rules. The targets of the pawns,
+// knights, bishops, rooks and queens are popcounted, promotions counting once per
+// promoted piece. The moves of the king, the pinned pieces and the en passant
+// captures are few, and need more checks, so they are generated as usual.
+func (b *Board) standardMoveCount() int {
+	var kingLocation uint8
+	var ourPiecesPtr *Bitboards
+	var promotionRank uint64
+	if b.Wtomove { // assumes only one king
+		kingLocation = uint8(bits.TrailingZeros64(b.White.Kings))
+		ourPiecesPtr = &(b.White)
+		promotionRank = onlyRank[7]
+	} else {
+		kingLocation = uint8(bits.TrailingZeros64(b.Black.Kings))
+		ourPiecesPtr = &(b.Black)
+		promotionRank = onlyRank[0]
+	}
+	var buffer [32]Move
+	moves := buffer[:0]
+
+	// If in check, only king moves are possible
+	kingAttackers, blockDest := b.CountAttacks(b.Wtomove, kingLocation, 2)
+	if kingAttackers >= 2 {
+		b.kingPushes(&moves, ourPiecesPtr, everything)
+		return len(moves)
+	}
+	allowDest := everything
+	if kingAttackers == 1 {
+		allowDest = blockDest
+		b.kingPushes(&moves, ourPiecesPtr, everything)
+	} else {
+		b.kingMoves(&moves, everything)
+	}
+	nonpinned := ^b.generatePinnedMoves(&moves, everything, allowDest)
+	var epBitboard uint64
+	if b.enpassant > 0 {
+		epBitboard = uint64(1) << b.enpassant
+		b.pawnCaptures(&moves, nonpinned, epBitboard)
+	}
+	count := len(moves)
+
+	// Pawns: the targets of each direction come from distinct pawns
+	pushes, doublePushes := b.pawnPushBitboards(nonpinned)
+	east, west := b.pawnCaptureBitboards(nonpinned)
+	for _, targets := range [3]uint64{pushes, east &^ epBitboard, west &^ epBitboard} {
+		targets &= allowDest
+		count += bits.OnesCount64(targets&^promotionRank) + 4*bits.OnesCount64(targets&promotionRank)
+	}
+	count += bits.OnesCount64(doublePushes & allowDest)
+
+	// Pieces
+	allPieces := b.White.All | b.Black.All
+	allowDest &= ^ourPiecesPtr.All
+	for knights := ourPiecesPtr.Knights & nonpinned; knights != 0; knights &= knights - 1 {
+		count += bits.OnesCount64(knightMasks[bits.TrailingZeros64(knights)] & allowDest)
+	}
+	for diagonal := (ourPiecesPtr.Bishops | ourPiecesPtr.Queens) & nonpinned; diagonal != 0; diagonal &= diagonal - 1 {
+		count += bits.OnesCount64(CalculateBishopMoveBitboard(uint8(bits.TrailingZeros64(diagonal)), allPieces) & allowDest)
+	}
+	for orthogonal := (ourPiecesPtr.Rooks | ourPiecesPtr.Queens) & nonpinned; orthogonal != 0; orthogonal &= orthogonal - 1 {
+		count += bits.OnesCount64(CalculateRookMoveBitboard(uint8(bits.TrailingZeros64(orthogonal)), allPieces) & allowDest)
+	}
+	return count
+}
+
+// Fills in the legal destinations under the standard chess rules, like
+// standardMoveCount: the targets of the pawns, knights, bishops, rooks and queens
+// are read from their bitboards, and the moves of the king, the pinned pieces and
+// the en passant captures are generated.
+func (b *Board) standardDestinations(destinations *[64]uint64) {
+	var kingLocation uint8
+	var ourPiecesPtr *Bitboards
+	// Offsets to the origins of the pawn pushes, double pushes, east and west captures
+	pawnOrigins := [4]int{-8, -16, -9, -7}
+	if b.Wtomove { // assumes only one king
+		kingLocation = uint8(bits.TrailingZeros64(b.White.Kings))
+		ourPiecesPtr = &(b.White)
+	} else {
+		kingLocation = uint8(bits.TrailingZeros64(b.Black.Kings))
+		ourPiecesPtr = &(b.Black)
+		pawnOrigins = [4]int{8, 16, 7, 9}
+	}
+	var buffer [32]Move
+	moves := buffer[:0]
+
+	// If in check, only king moves are possible
+	kingAttackers, blockDest := b.CountAttacks(b.Wtomove, kingLocation, 2)
+	if kingAttackers >= 2 {
+		b.kingPushes(&moves, ourPiecesPtr, everything)
+		for _, m := range moves {
+			destinations[m.From()] |= uint64(1) << m.To()
+		}
+		return
+	}
+	allowDest := everything
+	if kingAttackers == 1 {
+		allowDest = blockDest
+		b.kingPushes(&moves, ourPiecesPtr, everything)
+	} else {
+		b.kingMoves(&moves, everything)
+	}
+	nonpinned := ^b.generatePinnedMoves(&moves, everything, allowDest)
+	var epBitboard uint64
+	if b.enpassant > 0 {
+		epBitboard = uint64(1) << b.enpassant
+		b.pawnCaptures(&moves, nonpinned, epBitboard)
+	}
+	for _, m := range moves {
+		destinations[m.From()] |= uint64(1) << m.To()
+	}
+
+	// Pawns: the targets of each direction come from distinct pawns
+	pushes, doublePushes := b.pawnPushBitboards(nonpinned)
+	east, west := b.pawnCaptureBitboards(nonpinned)
+	for i, targets := range [4]uint64{pushes, doublePushes, east &^ epBitboard, west &^ epBitboard} {
+		for targets &= allowDest; targets != 0; targets &= targets - 1 {
+			target := bits.TrailingZeros64(targets)
+			destinations[target+pawnOrigins[i]] |= uint64(1) << target
+		}
+	}
+
+	// Pieces
+	allPieces := b.White.All | b.Black.All
+	allowDest &= ^ourPiecesPtr.All
+	for knights := ourPiecesPtr.Knights & nonpinned; knights != 0; knights &= knights - 1 {
+		knight := bits.TrailingZeros64(knights)
+		destinations[knight] = knightMasks[knight] & allowDest
+	}
+	for diagonal := (ourPiecesPtr.Bishops | ourPiecesPtr.Queens) & nonpinned; diagonal != 0; diagonal &= diagonal - 1 {
+		slider := bits.TrailingZeros64(diagonal)
+		destinations[slider] |= CalculateBishopMoveBitboard(uint8(slider), allPieces) & allowDest
+	}
+	for orthogonal := (ourPiecesPtr.Rooks | ourPiecesPtr.Queens) & nonpinned; orthogonal != 0; orthogonal &= orthogonal - 1 {
+		slider := bits.TrailingZeros64(orthogonal)
+		destinations[slider] |= CalculateRookMoveBitboard(uint8(slider), allPieces) & allowDest
+	}
+}
+
+// Returns whether the side to move has at least one legal move. Stops at the first
+// legal move found, so it is much cheaper than generating all the moves.
+func (b *Board) HasLegalMove() bool {
+	if b.rules != nil {
+		return len(b.rules.GenerateMoves(b, Nothing)) != 0
+	}
+	return b.standardHasLegalMove()
+}
+
+// Returns whether the side to move has no legal move and its king is in check.
+func (b *Board) IsCheckmate() bool {
+	return !b.HasLegalMove() && b.OurKingInCheck()
+}
+
+// Returns whether the side to move has no legal move and its king is not in check.
+func (b *Board) IsStalemate() bool {
+	return !b.HasLegalMove() && !b.OurKingInCheck()
+}
+
+// Looks for a legal move under the standard chess rules: king moves first, as they
+// are the most likely to exist, then the pawns, knights and sliders, by their target
+// bitboards. The pinned pieces and en passant captures come last. Castling is not
+// needed, since it requires a legal king step towards the rook.
+func (b *Board) standardHasLegalMove() bool {
+	var kingLocation uint8
+	var ourPiecesPtr *Bitboards
+	if b.Wtomove { // assumes only one king
+		kingLocation = uint8(bits.TrailingZeros64(b.White.Kings))
+		ourPiecesPtr = &(b.White)
+	} else {
+		kingLocation = uint8(bits.TrailingZeros64(b.Black.Kings))
+		ourPiecesPtr = &(b.Black)
+	}
+	var buffer [32]Move
+	moves := buffer[:0]
+
+	b.kingPushes(&moves, ourPiecesPtr, everything)
+	if len(moves) != 0 {
+		return true
+	}
+	// If in double check, only king moves are possible
+	kingAttackers, blockDest := b.CountAttacks(b.Wtomove, kingLocation, 2)
+	if kingAttackers >= 2 {
+		return false
+	}
+	allowDest := everything
+	if kingAttackers == 1 {
+		allowDest = blockDest
+	}
+	nonpinned := ^b.generatePinnedMoves(&moves, 0, allowDest)
+
+	// Pawns
+	var epBitboard uint64
+	if b.enpassant > 0 {
+		epBitboard = uint64(1) << b.enpassant
+	}
+	pushes, doublePushes := b.pawnPushBitboards(nonpinned)
+	east, west := b.pawnCaptureBitboards(nonpinned)
+	if (pushes|doublePushes|(east|west)&^epBitboard)&allowDest != 0 {
+		return true
+	}
+
+	// Pieces
+	allPieces := b.White.All | b.Black.All
+	targets := allowDest &^ ourPiecesPtr.All
+	for knights := ourPiecesPtr.Knights & nonpinned; knights != 0; knights &= knights - 1 {
+		if knightMasks[bits.TrailingZeros64(knights)]&targets != 0 {
+			return true
+		}
+	}
+	for diagonal := (ourPiecesPtr.Bishops | ourPiecesPtr.Queens) & nonpinned; diagonal != 0; diagonal &= diagonal - 1 {
+		if CalculateBishopMoveBitboard(uint8(bits.TrailingZeros64(diagonal)), allPieces)&targets != 0 {
+			return true
+		}
+	}
+	for orthogonal := (ourPiecesPtr.Rooks | ourPiecesPtr.Queens) & nonpinned; orthogonal != 0; orthogonal &= orthogonal - 1 {
+		if CalculateRookMoveBitboard(uint8(bits.TrailingZeros64(orthogonal)), allPieces)&targets != 0 {
+			return true
+		}
+	}
+
+	b.generatePinnedMoves(&moves, everything, allowDest)
+	if epBitboard != 0 {
+		b.pawnCaptures(&moves, nonpinned, epBitboard)
+	}
+	return len(moves) != 0
 }
 
 // Calculate the available moves for absolutely pinned pieces (pinned to the king).
+// Only the pieces on the origins squares are moved.
 // We are only allowed to move to squares in allowDest, to block checks.
 // Return a bitboard of all pieces that are pinned.
-func (b *Board) generatePinnedMoves(moveList *[]Move, allowDest uint64) uint64 {
+func (b *Board) generatePinnedMoves(moveList *[]Move, origins uint64, allowDest uint64) uint64 {
 	var ourKingIdx uint8
 	var ourPieces, oppPieces *Bitboards
 	var allPinnedPieces uint64 = 0
@@ -175,7 +492,10 @@ func (b *Board) generatePinnedMoves(moveList *[]Move, allowDest uint64) uint64 {
 		if !sameRank && !sameFile {
 			continue // it's just an intersection, not a pin
 		}
-		allPinnedPieces |= pinnedPiece        // store the pinned piece location
+		allPinnedPieces |= pinnedPiece // store the pinned piece location
+		if pinnedPiece&origins == 0 {
+			continue
+		}
 		if pinnedPiece&ourPieces.Pawns != 0 { // it's a pawn; we might be able to push it
 			if sameFile { // push the pawn
 				var pawnTargets uint64 = 0
@@ -221,6 +541,9 @@ func (b *Board) generatePinnedMoves(moveList *[]Move, allowDest uint64) uint64 {
 			continue
 		}
 		allPinnedPieces |= pinnedPiece // store pinned piece
+		if pinnedPiece&origins == 0 {
+			continue
+		}
 		// if it's a pawn we might be able to capture with it
 		// the capture square must also be in allowdest
 		if pinnedPiece&ourPieces.Pawns != 0 {
@@ -243,7 +566,7 @@ func (b *Board) generatePinnedMoves(moveList *[]Move, allowDest uint64) uint64 {
 
 			// Fix for en-passant captures by pinned pawns
 			// https://github.com/dylhunn/dragontoothmg/pull/6
-			if b.enpassant > 0 && bishopTargets&(1<<b.enpassant) != 0 {
+			if b.enpassant > 0 && bishopTargets&allowDest&(1<<b.enpassant) != 0 {
 				if (b.Wtomove && ((pinnedPieceIdx+9) == b.enpassant) || ((pinnedPieceIdx + 7) == b.enpassant)) ||
 					(!b.Wtomove && ((pinnedPieceIdx-9) == b.enpassant) || ((pinnedPieceIdx - 7) == b.enpassant)) {
 					var move Move
@@ -325,11 +648,10 @@ func (b *Board) pawnPushBitboards(nonpinned uint64) (targets uint64, doubleTarge
 
 // A function that computes available pawn captures.
 // Only pieces marked nonpinned can be moved. Only squares in allowDest can be moved to.
+// When in check, allowDest should include the en passant square, which is always
+// tried: the capture may remove the checking pawn.
 func (b *Board) pawnCaptures(moveList *[]Move, nonpinned uint64, allowDest uint64) {
 	east, west := b.pawnCaptureBitboards(nonpinned)
-	if b.enpassant > 0 { // always allow us to try en-passant captures
-		allowDest = allowDest | 1<<b.enpassant
-	}
 	east, west = east&allowDest, west&allowDest
 	dirbitboards := [2]uint64{east, west}
 	if !b.Wtomove {
@@ -369,7 +691,7 @@ func (b *Board) pawnCaptures(moveList *[]Move, nonpinned uint64, allowDest uint6
 				ourPieces.All |= (uint64(1) << move.To())
 				oppPieces.Pawns &= ^(uint64(1) << enpassantEnemy)
 				oppPieces.All &= ^(uint64(1) << enpassantEnemy)
-				kingInCheck := b.OurKingInCheck()
+				kingInCheck := b.standardKingInCheck()
 				ourPieces.Pawns |= (uint64(1) << move.From())
 				ourPieces.All |= (uint64(1) << move.From())
 				ourPieces.Pawns &= ^(uint64(1) << move.To())
@@ -435,8 +757,8 @@ func (b *Board) knightMoves(moveList *[]Move, nonpinned uint64, allowDest uint64
 	}
 }
 
-// Computes king moves without castling.
-func (b *Board) kingPushes(moveList *[]Move, ptrToOurBitboards *Bitboards) {
+// Computes king moves without castling. Only squares in allowDest can be moved to.
+func (b *Board) kingPushes(moveList *[]Move, ptrToOurBitboards *Bitboards, allowDest uint64) {
 	ourKingLocation := uint8(bits.TrailingZeros64(ptrToOurBitboards.Kings))
 	noFriendlyPieces := ^(ptrToOurBitboards.All)
 
@@ -446,7 +768,7 @@ func (b *Board) kingPushes(moveList *[]Move, ptrToOurBitboards *Bitboards) {
 	oldKings := ptrToOurBitboards.Kings
 	ptrToOurBitboards.Kings = 0
 	ptrToOurBitboards.All &= ^(uint64(1) << ourKingLocation)
-	targets := kingMasks[ourKingLocation] & noFriendlyPieces
+	targets := kingMasks[ourKingLocation] & noFriendlyPieces & allowDest
 	for targets != 0 {
 		target := bits.TrailingZeros64(targets)
 		targets &= targets - 1
@@ -466,8 +788,8 @@ func (b *Board) kingPushes(moveList *[]Move, ptrToOurBitboards *Bitboards) {
 // First, if castling is possible, verifies the checking prohibitions on castling.
 // Then, outputs castling moves (if any), and king moves.
 // Not thread-safe, since the king is removed from the board to compute
-// king-danger squares.
-func (b *Board) kingMoves(moveList *[]Move) {
+// king-danger squares. Only squares in allowDest can be moved to.
+func (b *Board) kingMoves(moveList *[]Move, allowDest uint64) {
 	var ptrToOurBitboards *Bitboards
 	if b.Wtomove {
 		ptrToOurBitboards = &(b.White)
@@ -476,13 +798,13 @@ func (b *Board) kingMoves(moveList *[]Move) {
 	}
 
 	// castling
-	b.kingCastlingMoves(moveList)
+	b.kingCastlingMoves(moveList, allowDest)
 	// non-castling
-	b.kingPushes(moveList, ptrToOurBitboards)
+	b.kingPushes(moveList, ptrToOurBitboards, allowDest)
 }
 
-// Generate only castling moves, if available.
-func (b *Board) kingCastlingMoves(moveList *[]Move) {
+// Generate only castling moves, if available, to the squares in allowDest.
+func (b *Board) kingCastlingMoves(moveList *[]Move, allowDest uint64) {
 	// castling
 	var ourKingLocation uint8
 	var CanCastleQueenside, CanCastleKingside bool
@@ -507,12 +829,12 @@ func (b *Board) kingCastlingMoves(moveList *[]Move) {
 		CanCastleKingside = b.BlackCanCastleKingside() &&
 			kingsideClear && !b.AnyUnderDirectAttack(false, 61, 62)
 	}
-	if CanCastleKingside {
+	if CanCastleKingside && allowDest&(uint64(1)<<(ourKingLocation+2)) != 0 {
 		var move Move
 		move.Setfrom(Square(ourKingLocation)).Setto(Square(ourKingLocation + 2))
 		*moveList = append(*moveList, move)
 	}
-	if CanCastleQueenside {
+	if CanCastleQueenside && allowDest&(uint64(1)<<(ourKingLocation-2)) != 0 {
 		var move Move
 		move.Setfrom(Square(ourKingLocation)).Setto(Square(ourKingLocation - 2))
 		*moveList = append(*moveList, move)
@@ -583,6 +905,98 @@ func (b *Board) queenMoves(moveList *[]Move, nonpinned uint64, allowDest uint64)
 	}
 }
 
+// Generates the moves of the side to move without verifying that they leave the king
+// safe, as needed by variants with their own legality rules. Castling is not generated.
+// If piece is not Nothing, only the moves of that piece are generated.
+func (b *Board) pseudoLegalMoves(moveList *[]Move, piece Piece) {
+	var ourPieces *Bitboards
+	if b.Wtomove {
+		ourPieces = &(b.White)
+	} else {
+		ourPieces = &(b.Black)
+	}
+	if piece == Nothing || piece == Pawn {
+		b.pawnPushes(moveList, everything, everything)
+		b.pawnPseudoCaptures(moveList)
+	}
+	if piece == Nothing || piece == Knight {
+		b.knightMoves(moveList, everything, everything)
+	}
+	if piece == Nothing || piece == Rook {
+		b.rookMoves(moveList, everything, everything)
+	}
+	if piece == Nothing || piece == Bishop {
+		b.bishopMoves(moveList, everything, everything)
+	}
+	if piece == Nothing || piece == Queen {
+		b.queenMoves(moveList, everything, everything)
+	}
+	if piece == Nothing || piece == King {
+		// there may be any number of kings
+		for ourKings := ourPieces.Kings; ourKings != 0; ourKings &= ourKings - 1 {
+			currKing := bits.TrailingZeros64(ourKings)
+			genMovesFromTargets(moveList, Square(currKing), kingMasks[currKing]&^ourPieces.All)
+		}
+	}
+}
+
+// Generates all pawn captures, including en passant, without any legality check.
+func (b *Board) pawnPseudoCaptures(moveList *[]Move) {
+	east, west := b.pawnCaptureBitboards(everything)
+	dirbitboards := [2]uint64{east, west}
+	if !b.Wtomove {
+		dirbitboards[0], dirbitboards[1] = dirbitboards[1], dirbitboards[0]
+	}
+	for dir, board := range dirbitboards { // for east and west
+		for board != 0 {
+			target := bits.TrailingZeros64(board)
+			board &= board - 1
+			var move Move
+			move.Setto(Square(target))
+			if b.Wtomove {
+				move.Setfrom(Square(target - (9 - (dir * 2))))
+			} else {
+				move.Setfrom(Square(target + (9 - (dir * 2))))
+			}
+			if target >= 56 || target <= 7 {
+				for i := Piece(Knight); i <= Queen; i++ {
+					move.Setpromote(i)
+					*moveList = append(*moveList, move)
+				}
+				continue
+			}
+			*moveList = append(*moveList, move)
+		}
+	}
+}
+
+// Generates the castling moves allowed by the castling rights and a clear path
+// between the king and the rook, without testing for attacked squares.
+func (b *Board) castlingCandidates(moveList *[]Move) {
+	allPieces := b.White.All | b.Black.All
+	var kingsideCastle, queensideCastle bool
+	var kingLocation uint8
+	if b.Wtomove {
+		kingLocation = 4
+		kingsideCastle = b.WhiteCanCastleKingside() && allPieces&((1<<5)|(1<<6)) == 0
+		queensideCastle = b.WhiteCanCastleQueenside() && allPieces&((1<<3)|(1<<2)|(1<<1)) == 0
+	} else {
+		kingLocation = 60
+		kingsideCastle = b.BlackCanCastleKingside() && allPieces&((1<<61)|(1<<62)) == 0
+		queensideCastle = b.BlackCanCastleQueenside() && allPieces&((1<<57)|(1<<58)|(1<<59)) == 0
+	}
+	if kingsideCastle {
+		var move Move
+		move.Setfrom(Square(kingLocation)).Setto(Square(kingLocation + 2))
+		*moveList = append(*moveList, move)
+	}
+	if queensideCastle {
+		var move Move
+		move.Setfrom(Square(kingLocation)).Setto(Square(kingLocation - 2))
+		*moveList = append(*moveList, move)
+	}
+}
+
 // Helper: converts a targets bitboard into moves, and adds them to the moves list.
 func genMovesFromTargets(moveList *[]Move, origin Square, targets uint64) {
 	for targets != 0 {
@@ -606,6 +1020,14 @@ func (b *Board) AnyUnderDirectAttack(byBlack bool, squares ...uint8) bool {
 }
 
 func (b *Board) OurKingInCheck() bool {
+	if b.rules != nil {
+		return b.rules.InCheck(b)
+	}
+	return b.standardKingInCheck()
+}
+
+// Returns whether the king of the side to move is in check, assuming a single king.
+func (b *Board) standardKingInCheck() bool {
 	byBlack := b.Wtomove
 	var origin uint8
 	if b.Wtomove {
@@ -653,9 +1075,7 @@ func (b *Board) CountAttacks(byBlack bool, origin uint8, abortEarly int) (int, u
 		return numAttacks, blockerDestinations
 	}
 	// find attacking bishops and queens
-	diag_candidates := magicBishopBlockerMasks[origin] & allPieces
-	diag_dbindex := (diag_candidates * magicNumberBishop[origin]) >> magicBishopShifts[origin]
-	origin_diag_rays := magicMovesBishop[origin][diag_dbindex]
+	origin_diag_rays := CalculateBishopMoveBitboard(origin, allPieces)
 	diag_attackers := origin_diag_rays & (opponentPieces.Bishops | opponentPieces.Queens)
 	numAttacks += bits.OnesCount64(diag_attackers)
 	blockerDestinations |= diag_attackers
@@ -672,9 +1092,7 @@ func (b *Board) CountAttacks(byBlack bool, origin uint8, abortEarly int) (int, u
 	}
 
 	// find attacking rooks and queens
-	ortho_candidates := magicRookBlockerMasks[origin] & allPieces
-	ortho_dbindex := (ortho_candidates * magicNumberRook[origin]) >> magicRookShifts[origin]
-	origin_ortho_rays := magicMovesRook[origin][ortho_dbindex]
+	origin_ortho_rays := CalculateRookMoveBitboard(origin, allPieces)
 	ortho_attackers := origin_ortho_rays & (opponentPieces.Rooks | opponentPieces.Queens)
 	numAttacks += bits.OnesCount64(ortho_attackers)
 	blockerDestinations |= ortho_attackers
@@ -724,9 +1142,17 @@ func (b *Board) CountAttacks(byBlack bool, origin uint8, abortEarly int) (int, u
 // rookTargets := CalculateRookMoveBitboard(myRookLoc, allPieces) & (^myPieces)
 // Externally useful for evaluation functions.
 func CalculateRookMoveBitboard(currRook uint8, allPieces uint64) uint64 {
+	if usePEXT {
+		return pextRookAttacks(currRook, allPieces)
+	}
+	return magicRookAttacks(currRook, allPieces)
+}
+
+// Looks up the attacks of a rook in the magic tables.
+func magicRookAttacks(currRook uint8, allPieces uint64) uint64 {
 	blockers := magicRookBlockerMasks[currRook] & allPieces
 	dbindex := (blockers * magicNumberRook[currRook]) >> magicRookShifts[currRook]
-	targets := magicMovesRook[currRook][dbindex]
+	targets := magicMoves[uint64(magicRookOffsets[currRook])+dbindex]
 	return targets
 }
 
@@ -735,8 +1161,28 @@ func CalculateRookMoveBitboard(currRook uint8, allPieces uint64) uint64 {
 // bishopTargets := CalculateBishopMoveBitboard(myBishopLoc, allPieces) & (^myPieces)
 // Externally useful for evaluation functions.
 func CalculateBishopMoveBitboard(currBishop uint8, allPieces uint64) uint64 {
+	if usePEXT {
+		return pextBishopAttacks(currBishop, allPieces)
+	}
+	return magicBishopAttacks(currBishop, allPieces)
+}
+
+// Looks up the attacks of a bishop in the magic tables.
+func magicBishopAttacks(currBishop uint8, allPieces uint64) uint64 {
 	blockers := magicBishopBlockerMasks[currBishop] & allPieces
 	dbindex := (blockers * magicNumberBishop[currBishop]) >> magicBishopShifts[currBishop]
-	targets := magicMovesBishop[currBishop][dbindex]
+	targets := magicMoves[uint64(magicBishopOffsets[currBishop])+dbindex]
 	return targets
 }
+
+// Calculates the attack bitboard for a knight. Like the slider functions, this
+// includes squares holding friendly pieces.
+func CalculateKnightMoveBitboard(currKnight uint8) uint64 {
+	return knightMasks[currKnight]
+}
+
+// Calculates the attack bitboard for a king, without castling. Like the slider
+// functions, this includes squares holding friendly pieces.
+func CalculateKingMoveBitboard(currKing uint8) uint64 {
+	return kingMasks[currKing]
+}
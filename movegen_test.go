@@ -3,6 +3,7 @@ package dragontoothmg
 import (
 	"fmt"
 	"math/bits"
+	"slices"
 	"testing"
 )
 
@@ -91,7 +92,7 @@ func TestKingPositions(t *testing.T) {
 	for k, v := range positions {
 		moves := make([]Move, 0, 45)
 		b := ParseFen(k)
-		b.kingMoves(&moves)
+		b.kingMoves(&moves, everything)
 		if len(moves) != v {
 			t.Error("King moves: wrong length. Expected", v, "but got",
 				len(moves), "\nFor position:", k)
@@ -231,7 +232,7 @@ func TestPinnedBishop(t *testing.T) {
 	for k, v := range positions {
 		moves := make([]Move, 0, 45)
 		b := ParseFen(k)
-		b.generatePinnedMoves(&moves, everything)
+		b.generatePinnedMoves(&moves, everything, everything)
 		if len(moves) != v {
 			t.Error("Legal moves for pinned bishops: wrong length. Expected", v, "but got", len(moves), "for position", b.ToFen())
 		}
@@ -246,7 +247,7 @@ func TestPinnedKnight(t *testing.T) {
 	for k, v := range positions {
 		moves := make([]Move, 0, 45)
 		b := ParseFen(k)
-		b.generatePinnedMoves(&moves, everything)
+		b.generatePinnedMoves(&moves, everything, everything)
 		if len(moves) != v {
 			t.Error("Legal moves for pinned bishops: wrong length. Expected", v, "but got", len(moves), "for position", b.ToFen())
 		}
@@ -261,7 +262,7 @@ func TestPinnedQueen(t *testing.T) {
 	for k, v := range positions {
 		moves := make([]Move, 0, 45)
 		b := ParseFen(k)
-		b.generatePinnedMoves(&moves, everything)
+		b.generatePinnedMoves(&moves, everything, everything)
 		if len(moves) != v {
 			t.Error("Legal moves for pinned bishops: wrong length. Expected", v, "but got", len(moves), "for position", b.ToFen())
 		}
@@ -294,7 +295,7 @@ func TestDiagPins(t *testing.T) {
 	for k, v := range positions {
 		moves := make([]Move, 0, 45)
 		b := ParseFen(k)
-		result := b.generatePinnedMoves(&moves, everything)
+		result := b.generatePinnedMoves(&moves, everything, everything)
 		if len(moves) != v {
 			t.Error("Legal moves for diagonal pins: wrong length. Expected", v, "but got", len(moves), "for position", b.ToFen())
 		}
@@ -357,7 +358,7 @@ func TestOrthoPins(t *testing.T) {
 	for k, v := range positions {
 		moves := make([]Move, 0, 45)
 		b := ParseFen(k)
-		result := b.generatePinnedMoves(&moves, everything)
+		result := b.generatePinnedMoves(&moves, everything, everything)
 		if len(moves) != v {
 			t.Error("Legal moves for orthogonal pins: wrong length. Expected", v, "but got", len(moves), "for position", b.ToFen())
 			printMoves(moves)
@@ -549,3 +550,172 @@ func TestLegalMoves(t *testing.T) {
 		}
 	}
 }
+
+// Moves from a square, to a square and of a piece type are the matching legal moves,
+// on every position of the perft trees.
+func TestGenerateMovesMasked(t *testing.T) {
+	fens := []string{
+		Startpos,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0",
+		"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 0",
+		"n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1",
+		"rn1qk1nr/pb1pb1pp/p7/2pPpP2/8/6P1/PPP2PKP/RNBQ2NR w kq c6 0 9",
+		"8/8/8/2k5/3Pp3/8/8/4K3 b - d3 0 1",
+	}
+	for _, fen := range fens {
+		b := ParseFen(fen)
+		checkMaskedMovesTree(t, &b, 3)
+	}
+
+	z := NewBoardVariant(VariantCrazyhouse)
+	for _, move := range []string{"e2e4", "d7d5", "e4d5", "d8d5"} {
+		z.Make(parseMove(move))
+	}
+	if moves := z.GenerateMovesTo(44); !slices.Contains(moves, parseMove("P@e6")) ||
+		len(moves) != 1 {
+		t.Error("Wrong moves to e6 in crazyhouse:", moves)
+	}
+	if moves := z.GenerateMovesFrom(4); len(moves) != 1 {
+		t.Error("Wrong moves from e1 in crazyhouse:", moves)
+	}
+}
+
+func checkMaskedMovesTree(t *testing.T, b *Board, depth int) {
+	legal := b.GenerateLegalMoves()
+	destinations := b.LegalDestinations()
+	matching := func(keep func(m Move) bool) []Move {
+		var moves []Move
+		for _, m := range legal {
+			if keep(m) {
+				moves = append(moves, m)
+			}
+		}
+		slices.Sort(moves)
+		return moves
+	}
+	sorted := func(moves []Move) []Move {
+		slices.Sort(moves)
+		return moves
+	}
+	for sq := Square(0); sq < 64; sq++ {
+		from := matching(func(m Move) bool { return m.From() == uint8(sq) })
+		if !slices.Equal(sorted(b.GenerateMovesFrom(sq)), from) {
+			t.Fatal("Wrong moves from", IndexToAlgebraic(sq), "in", b.ToFen())
+		}
+		var fromDestinations uint64
+		for _, m := range from {
+			fromDestinations |= uint64(1) << m.To()
+		}
+		if destinations[sq] != fromDestinations {
+			t.Fatal("Wrong destinations from", IndexToAlgebraic(sq), "in", b.ToFen())
+		}
+		to := matching(func(m Move) bool { return m.To() == uint8(sq) })
+		if !slices.Equal(sorted(b.GenerateMovesTo(sq)), to) {
+			t.Fatal("Wrong moves to", IndexToAlgebraic(sq), "in", b.ToFen())
+		}
+	}
+	for piece := Piece(Pawn); piece <= King; piece++ {
+		ofPiece := matching(func(m Move) bool {
+			p, _ := b.PieceAt(Square(m.From()))
+			return p == piece
+		})
+		if !slices.Equal(sorted(b.GenerateMovesForPiece(piece)), ofPiece) {
+			t.Fatal("Wrong moves of piece", piece, "in", b.ToFen())
+		}
+	}
+
+	if depth <= 1 {
+		return
+	}
+	for _, m := range legal {
+		b.Make(m)
+		checkMaskedMovesTree(t, b, depth-1)
+		b.Undo()
+	}
+}
+
+// The move count equals the number of generated moves, on every position of the perft trees.
+func TestCountLegalMoves(t *testing.T) {
+	fens := []string{
+		Startpos,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0",
+		"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 0",
+		"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1",
+		"n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1",
+		"rn1qk1nr/pb1pb1pp/p7/2pPpP2/8/6P1/PPP2PKP/RNBQ2NR w kq c6 0 9",
+		"8/8/8/2k5/3Pp3/8/8/4K3 b - d3 0 1",
+		"8/8/8/KPp4r/8/8/8/6k1 w - c6 0 1",
+	}
+	var walk func(b *Board, depth int)
+	walk = func(b *Board, depth int) {
+		moves := b.GenerateLegalMoves()
+		if count := b.CountLegalMoves(); count != len(moves) {
+			t.Fatal("Counted", count, "moves instead of", len(moves), "in", b.ToFen())
+		}
+		if depth <= 1 {
+			return
+		}
+		for _, m := range moves {
+			b.Make(m)
+			walk(b, depth-1)
+			b.Undo()
+		}
+	}
+	for _, fen := range fens {
+		b := ParseFen(fen)
+		walk(&b, 3)
+	}
+	for _, variant := range []Variant{VariantCrazyhouse, VariantAtomic, VariantAntichess} {
+		b := NewBoardVariant(variant)
+		if b.CountLegalMoves() != len(b.GenerateLegalMoves()) {
+			t.Error("Wrong move count in", variant)
+		}
+	}
+}
+
+func TestHasLegalMove(t *testing.T) {
+	tests := []struct {
+		fen       string
+		checkmate bool
+		stalemate bool
+	}{
+		{"rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3", true, false},
+		{"7k/5Q2/6K1/8/8/8/8/8 b - - 0 1", false, true},
+		// the only moves are of a pinned rook, an en passant capture, pawn pushes, knight moves
+		{"r7/8/8/8/R7/8/2q5/K6k w - - 0 1", false, false},
+		{"7k/8/4p3/3pP3/8/8/2q5/K7 w - d6 0 1", false, false},
+		{"7k/8/8/8/8/8/P1q5/K7 w - - 0 1", false, false},
+		{"7k/8/8/8/8/1q6/8/K1N5 w - - 0 1", false, false},
+		{Startpos, false, false},
+	}
+	for _, test := range tests {
+		b := ParseFen(test.fen)
+		if b.HasLegalMove() != (len(b.GenerateLegalMoves()) != 0) || b.IsCheckmate() != test.checkmate ||
+			b.IsStalemate() != test.stalemate {
+			t.Error("Wrong legal move detection in", test.fen)
+		}
+	}
+
+	var walk func(b *Board, depth int)
+	walk = func(b *Board, depth int) {
+		moves := b.GenerateLegalMoves()
+		if b.HasLegalMove() != (len(moves) != 0) {
+			t.Fatal("HasLegalMove is", b.HasLegalMove(), "with", len(moves), "moves in", b.ToFen())
+		}
+		if depth <= 1 {
+			return
+		}
+		for _, m := range moves {
+			b.Make(m)
+			walk(b, depth-1)
+			b.Undo()
+		}
+	}
+	b := ParseFen("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0")
+	walk(&b, 3)
+
+	v := NewBoardVariant(VariantAtomic)
+	if !v.HasLegalMove() || v.IsCheckmate() || v.IsStalemate() {
+		t.Error("Wrong legal move detection in atomic")
+	}
+}
@@ -0,0 +1,122 @@
+package dragontoothmg
+
+// Move information: a Move along with its moving piece, captured piece and kind,
+// so that consumers don't have to call IsCapture and DeterminePieceType again to
+// find whether a move is a capture, en passant, castling or a double push.
+// The information is read from the mailbox after the moves are generated: the
+// generators don't produce it. MakeMoveInfo uses it instead of deriving it again.
+
+// The kind of a move.
+type MoveKind uint8
+
+const (
+	MoveKindQuiet MoveKind = iota
+	MoveKindDoublePush
+	MoveKindCastleKingside
+	MoveKindCastleQueenside
+	MoveKindCapture
+	MoveKindEnPassant
+	MoveKindPromotion
+	MoveKindCapturePromotion
+	MoveKindDrop // crazyhouse
+)
+
+// Data stored inside, from LSB
+// 16 bits: the move
+// 3 bits: moving piece (the dropped piece for drops)
+// 3 bits: captured piece (a pawn for en passant)
+// 4 bits: kind
+
+// A move with its moving piece, captured piece and kind.
+type MoveInfo uint32
+
+// Packs a move with its information.
+func NewMoveInfo(m Move, piece Piece, captured Piece, kind MoveKind) MoveInfo {
+	return MoveInfo(m) | MoveInfo(piece&7)<<16 | MoveInfo(captured&7)<<19 | MoveInfo(kind&15)<<22
+}
+
+// The move itself.
+func (mi MoveInfo) Move() Move {
+	return Move(mi & 0xFFFF)
+}
+
+// The moving piece, before promotion. For drops, the dropped piece.
+func (mi MoveInfo) Piece() Piece {
+	return Piece(mi>>16) & 7
+}
+
+// The captured piece, a pawn for en passant captures, or Nothing.
+func (mi MoveInfo) Captured() Piece {
+	return Piece(mi>>19) & 7
+}
+
+// The kind of the move.
+func (mi MoveInfo) Kind() MoveKind {
+	return MoveKind(mi>>22) & 15
+}
+
+// Whether the move captures a piece, including en passant and capture-promotions.
+func (mi MoveInfo) IsCapture() bool {
+	kind := mi.Kind()
+	return kind == MoveKindCapture || kind == MoveKindEnPassant || kind == MoveKindCapturePromotion
+}
+
+// Whether the move promotes a pawn.
+func (mi MoveInfo) IsPromotion() bool {
+	kind := mi.Kind()
+	return kind == MoveKindPromotion || kind == MoveKindCapturePromotion
+}
+
+// Whether the move castles.
+func (mi MoveInfo) IsCastle() bool {
+	kind := mi.Kind()
+	return kind == MoveKindCastleKingside || kind == MoveKindCastleQueenside
+}
+
+// Returns the information of a move in the current position, read from the mailbox.
+// The move is assumed to be valid in the position.
+func (b *Board) MoveInfo(m Move) MoveInfo {
+	if m.IsDrop() {
+		return NewMoveInfo(m, m.Dropped(), Nothing, MoveKindDrop)
+	}
+	from, to := m.From(), m.To()
+	piece := Piece(b.mailbox[from] & 7)
+	captured := Piece(b.mailbox[to] & 7)
+	kind := MoveKindQuiet
+	switch {
+	case m.Promote() != Nothing:
+		kind = MoveKindPromotion
+		if captured != Nothing {
+			kind = MoveKindCapturePromotion
+		}
+	case captured != Nothing:
+		kind = MoveKindCapture
+	case piece == Pawn:
+		if to == b.enpassant && b.enpassant != 0 {
+			kind, captured = MoveKindEnPassant, Pawn
+		} else if delta := int(to) - int(from); (delta == 16 || delta == -16) && (from>>3 == 1 || from>>3 == 6) {
+			// the first-rank double pushes of horde pawns don't allow en passant
+			kind = MoveKindDoublePush
+		}
+	case piece == King:
+		if delta := int(to) - int(from); delta == 2 {
+			kind = MoveKindCastleKingside
+		} else if delta == -2 {
+			kind = MoveKindCastleQueenside
+		}
+	}
+	return NewMoveInfo(m, piece, captured, kind)
+}
+
+// Generates all legal moves with their information. A convenience wrapper that calls
+// MoveInfo on each generated move: it saves no work over GenerateLegalMoves, and
+// making the infos with MakeMoveInfo costs the same as making the moves with Make,
+// which derives the info itself.
+func (b *Board) GenerateMoveInfos() []MoveInfo {
+	moves := b.GenerateLegalMoves()
+	infos := make([]MoveInfo, len(moves))
+	for i, m := range moves {
+		infos[i] = b.MoveInfo(m)
+	}
+	return infos
+}
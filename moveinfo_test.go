@@ -0,0 +1,90 @@
+package dragontoothmg
+
+import "testing"
+
+func TestMoveInfo(t *testing.T) {
+	b := ParseFen("r3k2r/1P6/8/3pP3/8/8/P7/R3K2R w KQkq d6 0 1")
+	tests := []struct {
+		move     string
+		piece    Piece
+		captured Piece
+		kind     MoveKind
+	}{
+		{"a2a3", Pawn, Nothing, MoveKindQuiet},
+		{"a2a4", Pawn, Nothing, MoveKindDoublePush},
+		{"e1g1", King, Nothing, MoveKindCastleKingside},
+		{"e1c1", King, Nothing, MoveKindCastleQueenside},
+		{"e1f1", King, Nothing, MoveKindQuiet},
+		{"h1h8", Rook, Rook, MoveKindCapture},
+		{"e5d6", Pawn, Pawn, MoveKindEnPassant},
+		{"b7b8n", Pawn, Nothing, MoveKindPromotion},
+		{"b7a8q", Pawn, Rook, MoveKindCapturePromotion},
+	}
+	for _, test := range tests {
+		mi := b.MoveInfo(parseMove(test.move))
+		if mi.Move() != parseMove(test.move) || mi.Piece() != test.piece || mi.Captured() != test.captured ||
+			mi.Kind() != test.kind {
+			t.Error("Wrong info of", test.move, mi.Piece(), mi.Captured(), mi.Kind())
+		}
+		if mi.IsCapture() != (test.captured != Nothing) || mi.IsCapture() != IsCapture(mi.Move(), &b) ||
+			mi.IsPromotion() != (test.kind == MoveKindPromotion || test.kind == MoveKindCapturePromotion) ||
+			mi.IsCastle() != (test.kind == MoveKindCastleKingside || test.kind == MoveKindCastleQueenside) {
+			t.Error("Wrong info accessors of", test.move)
+		}
+	}
+
+	z := NewBoardVariant(VariantCrazyhouse)
+	drop := parseMove("N@e4")
+	if mi := z.MoveInfo(drop); mi.Kind() != MoveKindDrop || mi.Piece() != Knight || mi.Move() != drop {
+		t.Error("Wrong info of a drop", mi.Kind(), mi.Piece())
+	}
+}
+
+// Perft on moves made from their information
+func perftMoveInfo(b *Board, n int) int64 {
+	if n <= 0 {
+		return 1
+	}
+	var count int64 = 0
+	for _, mi := range b.GenerateMoveInfos() {
+		b.MakeMoveInfo(mi)
+		count += perftMoveInfo(b, n-1)
+		b.Undo()
+	}
+	return count
+}
+
+func TestMakeMoveInfo(t *testing.T) {
+	tests := []struct {
+		fen   string
+		depth int
+		nodes int64
+	}{
+		{"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0", 3, 97862},
+		{"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 0", 4, 43238},
+		{"n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1", 4, 182838},
+	}
+	for _, test := range tests {
+		b := ParseFen(test.fen)
+		if nodes := perftMoveInfo(&b, test.depth); nodes != test.nodes {
+			t.Error("Move info perft", test.depth, "of", test.fen, "expected", test.nodes, "got", nodes)
+		}
+		start := ParseFen(test.fen)
+		if b.ToFen() != start.ToFen() || !mailboxInSync(&b) {
+			t.Error("Move info perft didn't restore the board", b.ToFen())
+		}
+	}
+
+	// Crazyhouse drops and captures give the same positions as Make
+	z := NewBoardVariant(VariantCrazyhouse)
+	for _, move := range []string{"e2e4", "d7d5", "e4d5", "d8d5", "P@e6", "d5e6"} {
+		m := parseMove(move)
+		c := *z
+		c.History = append([]History(nil), z.History...)
+		c.MakeMoveInfo(z.MoveInfo(m))
+		z.Make(m)
+		if c.ToFen() != z.ToFen() || c.Hash() != z.Hash() {
+			t.Fatal("MakeMoveInfo of", move, "differs from Make:", c.ToFen())
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package dragontoothmg
+
+// A staged move picker for search. Moves are yielded lazily, in the order a search
+// most likely wants to try them, so that a cutoff on an early move saves generating
+// and sorting the rest:
+//
+// 1. The transposition table move
+// 2. Good captures (SEE >= 0), most valuable victim first, least valuable attacker next
+// 3. Quiet promotions, queen first
+// 4. The killer moves
+// 5. The counter-move
+// 6. Quiet moves, ordered by the history table
+// 7. Bad captures (SEE < 0)
+//
+// The moves of each stage are generated when the stage is reached: captures by the
+// per-kind generators restricted to the enemy pieces and the en passant square, then
+// promotions and quiet moves restricted to the empty squares, sorted out with
+// IsCapture. The TT move, killers and counter-move come from the caller's tables,
+// so they are checked with IsLegalMove, and never yielded twice. On variant boards,
+// all the legal moves are generated at once, and split into the same stages.
+
+import "slices"
+
+// Butterfly history of quiet moves, indexed by side (0 for white, 1 for black), then
+// the from and to squares. Higher scores are tried first.
+type HistoryTable [2][64][64]int32
+
+// Adds a bonus (or, if negative, a malus) to the history score of a quiet move
+// made by the given side.
+func (h *HistoryTable) Update(white bool, m Move, bonus int32) {
+	h[sideIndex(white)][m.From()][m.To()] += bonus
+}
+
+// Returns the history score of a move made by the given side.
+func (h *HistoryTable) Score(white bool, m Move) int32 {
+	return h[sideIndex(white)][m.From()][m.To()]
+}
+
+// The stages of the move picker, in order.
+const (
+	stageTTMove = iota
+	stageGenCaptures
+	stageGoodCaptures
+	stageGenPromotions
+	stagePromotions
+	stageKillers
+	stageCounter
+	stageGenQuiets
+	stageQuiets
+	stageBadCaptures
+	stageDone
+)
+
+// Yields the legal moves of a position in stages, best candidates first.
+// The board must not be changed while the picker is in use, except by making and
+// undoing moves in between calls to Next.
+type MovePicker struct {
+	board      *Board
+	history    *HistoryTable
+	ttMove     Move
+	killers    [2]Move
+	counter    Move
+	stage      int
+	skipQuiets bool
+
+	moves  []Move  // the moves of the current stage
+	scores []int32 // their scores, for the moves not picked yet
+	next   int     // index of the next move to pick
+	bad    []Move  // captures losing material, in the order they were found
+	all    []Move  // all the legal moves, on variant boards
+}
+
+// Returns a move picker for the position. The transposition table move, the killer
+// moves and the counter-move may be 0 if unknown, and may be illegal in the
+// position. The history table may be nil, leaving quiet moves unordered.
+func NewMovePicker(b *Board, ttMove Move, killers [2]Move, counter Move, history *HistoryTable) *MovePicker {
+	return &MovePicker{
+		board:   b,
+		history: history,
+		ttMove:  ttMove,
+		killers: killers,
+		counter: counter,
+		moves:   make([]Move, 0, kDefaultMoveListLength),
+		scores:  make([]int32, 0, kDefaultMoveListLength),
+	}
+}
+
+// Skips the killers, counter-move and quiet moves that were not yielded yet, such
+// as in quiescence search, or once a search decides to prune the quiet moves.
+// Promotions and bad captures are still yielded.
+func (mp *MovePicker) SkipQuiets() {
+	mp.skipQuiets = true
+}
+
+// Returns the next move, or 0 once all the legal moves were yielded.
+func (mp *MovePicker) Next() Move {
+	b := mp.board
+	for {
+		switch mp.stage {
+		case stageTTMove:
+			mp.stage++
+			if mp.ttMove != 0 && b.IsLegalMove(mp.ttMove) {
+				return mp.ttMove
+			}
+
+		case stageGenCaptures:
+			targets := b.opponentPieces().All
+			if b.enpassant > 0 {
+				targets |= uint64(1) << b.enpassant
+			}
+			mp.generate(Nothing, targets, func(m Move) bool {
+				return IsCapture(m, b)
+			})
+			for _, m := range mp.moves {
+				mp.scores = append(mp.scores, mvvLva(b, m))
+			}
+			mp.stage++
+
+		case stageGoodCaptures:
+			for mp.next < len(mp.moves) {
+				m := mp.pickBest()
+				if m == mp.ttMove {
+					continue
+				}
+				if b.rules == nil && !seeNotLosing(b, m) {
+					mp.bad = append(mp.bad, m)
+					continue
+				}
+				return m
+			}
+			mp.stage++
+
+		case stageGenPromotions:
+			promotionRank := onlyRank[7]
+			if !b.Wtomove {
+				promotionRank = onlyRank[0]
+			}
+			empty := ^(b.White.All | b.Black.All)
+			mp.generate(Pawn, empty&promotionRank, func(m Move) bool {
+				return m.Promote() != Nothing && !IsCapture(m, b)
+			})
+			for _, m := range mp.moves {
+				mp.scores = append(mp.scores, int32(SeeValues[m.Promote()]))
+			}
+			mp.stage++
+
+		case stagePromotions:
+			for mp.next < len(mp.moves) {
+				if m := mp.pickBest(); m != mp.ttMove {
+					return m
+				}
+			}
+			mp.stage++
+			mp.next = 0
+
+		case stageKillers:
+			for mp.next < len(mp.killers) && !mp.skipQuiets {
+				m := mp.killers[mp.next]
+				mp.next++
+				if m != mp.ttMove && (mp.next == 1 || m != mp.killers[0]) && mp.isQuietCandidate(m) {
+					return m
+				}
+			}
+			mp.stage++
+
+		case stageCounter:
+			mp.stage++
+			m := mp.counter
+			if !mp.skipQuiets && m != mp.ttMove && m != mp.killers[0] && m != mp.killers[1] &&
+				mp.isQuietCandidate(m) {
+				return m
+			}
+
+		case stageGenQuiets:
+			if mp.skipQuiets {
+				mp.stage = stageBadCaptures
+				mp.next = 0
+				continue
+			}
+			mp.generate(Nothing, ^(b.White.All | b.Black.All), func(m Move) bool {
+				return m.Promote() == Nothing && !IsCapture(m, b) && m != mp.ttMove &&
+					m != mp.killers[0] && m != mp.killers[1] && m != mp.counter
+			})
+			for _, m := range mp.moves {
+				var score int32
+				if mp.history != nil {
+					score = mp.history.Score(b.Wtomove, m)
+				}
+				mp.scores = append(mp.scores, score)
+			}
+			mp.stage++
+
+		case stageQuiets:
+			if mp.next < len(mp.moves) && !mp.skipQuiets {
+				return mp.pickBest()
+			}
+			mp.stage++
+			mp.next = 0
+
+		case stageBadCaptures:
+			if mp.next < len(mp.bad) {
+				mp.next++
+				return mp.bad[mp.next-1]
+			}
+			mp.stage++
+
+		default:
+			return 0
+		}
+	}
+}
+
+// Replaces the moves of the picker with the candidates of a stage, kept by the given
+// filter: the moves of the piece type (all pieces, if Nothing) to the target squares,
+// or all the legal moves on variant boards. The scores are cleared.
+func (mp *MovePicker) generate(piece Piece, targets uint64, keep func(m Move) bool) {
+	b := mp.board
+	mp.moves, mp.scores, mp.next = mp.moves[:0], mp.scores[:0], 0
+	if b.rules != nil {
+		if mp.all == nil {
+			mp.all = b.GenerateLegalMoves()
+		}
+		mp.moves = append(mp.moves, mp.all...)
+	} else {
+		b.standardMovesMasked(&mp.moves, piece, everything, targets)
+	}
+	mp.moves = slices.DeleteFunc(mp.moves, func(m Move) bool { return !keep(m) })
+}
+
+// Picks the move with the best score among the moves not picked yet.
+func (mp *MovePicker) pickBest() Move {
+	best := mp.next
+	for i := mp.next + 1; i < len(mp.moves); i++ {
+		if mp.scores[i] > mp.scores[best] {
+			best = i
+		}
+	}
+	mp.moves[mp.next], mp.moves[best] = mp.moves[best], mp.moves[mp.next]
+	mp.scores[mp.next], mp.scores[best] = mp.scores[best], mp.scores[mp.next]
+	mp.next++
+	return mp.moves[mp.next-1]
+}
+
+// Returns whether a move from the caller's tables is a legal quiet move.
+func (mp *MovePicker) isQuietCandidate(m Move) bool {
+	b := mp.board
+	return m != 0 && m.Promote() == Nothing && !IsCapture(m, b) && b.IsLegalMove(m)
+}
+
+// Returns the pieces of the side not to move.
+func (b *Board) opponentPieces() *Bitboards {
+	if b.Wtomove {
+		return &(b.Black)
+	}
+	return &(b.White)
+}
+
+// Scores a capture: most valuable victim first, then least valuable attacker.
+// Capture-promotions count the value of the promoted piece too.
+func mvvLva(b *Board, m Move) int32 {
+	victim := Piece(b.mailbox[m.To()] & 7)
+	if victim == Nothing { // en passant
+		victim = Pawn
+	}
+	attacker := Piece(b.mailbox[m.From()] & 7)
+	return int32(SeeValues[victim]+SeeValues[m.Promote()])*8 - int32(attacker)
+}
+
+// Returns whether a capture doesn't lose material. The SEE is skipped when the
+// victim is worth at least as much as the attacker.
+func seeNotLosing(b *Board, m Move) bool {
+	victim := Piece(b.mailbox[m.To()] & 7)
+	attacker := Piece(b.mailbox[m.From()] & 7)
+	if SeeValues[victim] >= SeeValues[attacker] {
+		return true
+	}
+	return b.SEE(m) >= 0
+}
@@ -0,0 +1,85 @@
+package dragontoothmg
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// Returns all the moves yielded by the picker.
+func pickAll(mp *MovePicker) []Move {
+	var moves []Move
+	for m := mp.Next(); m != 0; m = mp.Next() {
+		moves = append(moves, m)
+	}
+	return moves
+}
+
+// The picker yields every legal move exactly once, whatever moves it is given,
+// on random games of each variant.
+func TestMovePickerYieldsLegalMoves(t *testing.T) {
+	rng := rand.New(rand.NewSource(43))
+	var history HistoryTable
+	for _, variant := range []Variant{VariantStandard, VariantCrazyhouse, VariantAtomic, VariantAntichess} {
+		for game := 0; game < 10; game++ {
+			b := NewBoardVariant(variant)
+			var previous []Move
+			for ply := 0; ply < 80; ply++ {
+				legal := b.GenerateLegalMoves()
+				if len(legal) == 0 {
+					break
+				}
+				// Table moves, legal or not: from this position, or the previous ones
+				candidates := append(slices.Clone(legal), previous...)
+				pick := func() Move { return candidates[rng.Intn(len(candidates))] }
+				mp := NewMovePicker(b, pick(), [2]Move{pick(), pick()}, pick(), &history)
+				picked := pickAll(mp)
+				if len(picked) != len(legal) {
+					t.Fatal("Picked", len(picked), "moves instead of", len(legal), "in", b.ToFen())
+				}
+				slices.Sort(picked)
+				slices.Sort(legal)
+				if !slices.Equal(picked, legal) {
+					t.Fatal("Picked moves differ from the legal moves in", b.ToFen())
+				}
+				m := legal[rng.Intn(len(legal))]
+				history.Update(b.Wtomove, m, int32(rng.Intn(100)))
+				previous = legal
+				b.Make(m)
+			}
+		}
+	}
+}
+
+func TestMovePickerOrder(t *testing.T) {
+	b := ParseFen("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0")
+	var history HistoryTable
+	history.Update(true, parseMove("a2a3"), 50)
+	history.Update(true, parseMove("g2g3"), 100)
+	tt, killers, counter := parseMove("e1f1"), [2]Move{parseMove("a1b1"), parseMove("d5d6")}, parseMove("e1d1")
+	picked := pickAll(NewMovePicker(&b, tt, killers, counter, &history))
+	names := make([]string, len(picked))
+	for i, m := range picked {
+		names[i] = m.String()
+	}
+
+	// TT move, then good captures: the bishop first, then the pawns
+	expected := []string{"e1f1", "e2a6", "d5e6", "g2h3", "a1b1", "d5d6", "e1d1", "g2g3", "a2a3"}
+	slices.Sort(names[2:4]) // equal captures
+	if !slices.Equal(names[:len(expected)], expected) {
+		t.Error("Expected the moves to start with", expected, "got", names)
+	}
+	// Bad captures come last, the knight first. Qxh3 loses the queen to the h8 rook.
+	bad := names[len(names)-5:]
+	slices.Sort(bad[1:4])
+	if !slices.Equal(bad, []string{"f3f6", "e5d7", "e5f7", "e5g6", "f3h3"}) {
+		t.Error("Expected the bad captures last, got", names)
+	}
+
+	// In quiescence search, only the captures are yielded
+	mp := NewMovePicker(&b, 0, killers, counter, &history)
+	mp.SkipQuiets()
+	if picked := pickAll(mp); len(picked) != 8 {
+		t.Error("Expected 8 captures, got", picked)
+	}
+}
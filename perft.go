@@ -8,10 +8,10 @@ func Perft(b *Board, n int) int64 {
 	if n <= 0 {
 		return 1
 	}
-	moves := b.GenerateLegalMoves()
 	if n == 1 {
-		return int64(len(moves))
+		return int64(b.CountLegalMoves())
 	}
+	moves := b.GenerateLegalMoves()
 	var count int64 = 0
 	for _, move := range moves {
 		b.Make(move)
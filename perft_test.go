@@ -119,7 +119,14 @@ func TestPromotions(t *testing.T) {
 }
 
 func checkPerftResults(fen string, perftSolutions map[int]int64, t *testing.T) {
-	b := ParseFen(fen)
+	checkBoardPerftResults(ParseFen(fen), perftSolutions, t)
+}
+
+func checkVariantPerftResults(fen string, variant Variant, perftSolutions map[int]int64, t *testing.T) {
+	checkBoardPerftResults(ParseFenVariant(fen, variant), perftSolutions, t)
+}
+
+func checkBoardPerftResults(b Board, perftSolutions map[int]int64, t *testing.T) {
 	for i := 1; i <= len(perftSolutions); i++ {
 		beforeFen := b.ToFen()
 		result := Perft(&b, i)
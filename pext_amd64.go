@@ -0,0 +1,80 @@
+package dragontoothmg
+
+// Slider attacks with the BMI2 PEXT instruction. PEXT packs the blockers of a slider
+// into a dense index, so no magic numbers are needed, and the attack tables are
+// contiguous, with an offset for each square. The lookups are written in assembly
+// (see pext_amd64.s), and used instead of the magic ones when the CPU supports BMI2.
+// AMD processors before Zen 3 implement PEXT in microcode, which is slower than the
+// magics, so it is not used on them.
+
+// Whether the CPU has a fast PEXT instruction.
+var hasPEXT = detectPEXT()
+
+// Whether the slider lookups use PEXT instead of the magics.
+var usePEXT = hasPEXT
+
+var pextRookTable [102400]uint64
+var pextBishopTable [5248]uint64
+var pextRookOffsets [64]uint32
+var pextBishopOffsets [64]uint32
+
+// Implemented in pext_amd64.s.
+func cpuid(eaxArg uint32, ecxArg uint32) (eax uint32, ebx uint32, ecx uint32, edx uint32)
+func pextRookAttacks(square uint8, allPieces uint64) uint64
+func pextBishopAttacks(square uint8, allPieces uint64) uint64
+
+func init() {
+	if hasPEXT {
+		generatePextTable(pextRookTable[:], &pextRookOffsets, &magicRookBlockerMasks, magicRookAttacks)
+		generatePextTable(pextBishopTable[:], &pextBishopOffsets, &magicBishopBlockerMasks, magicBishopAttacks)
+	}
+}
+
+// Fills the attack table of a slider, square after square, from its magic lookups.
+// Enumerating the subsets of the blocker mask in increasing order gives their PEXT
+// indices in order too.
+func generatePextTable(table []uint64, offsets *[64]uint32, masks *[64]uint64,
+	magicAttacks func(uint8, uint64) uint64) {
+	offset := uint32(0)
+	for square := 0; square < 64; square++ {
+		offsets[square] = offset
+		mask := masks[square]
+		for blockers := uint64(0); ; blockers = (blockers - mask) & mask {
+			table[offset] = magicAttacks(uint8(square), blockers)
+			offset++
+			if blockers == mask {
+				break
+			}
+		}
+	}
+}
+
+// Returns whether the CPU supports BMI2, and is not an AMD processor before Zen 3.
+func detectPEXT() bool {
+	maxLeaf, vendor1, vendor3, vendor2 := cpuid(0, 0)
+	if maxLeaf < 7 {
+		return false
+	}
+	if _, ebx, _, _ := cpuid(7, 0); ebx&(1<<8) == 0 { // BMI2
+		return false
+	}
+	// "AuthenticAMD", spread over ebx, edx and ecx
+	if vendor1 == 0x68747541 && vendor2 == 0x69746e65 && vendor3 == 0x444d4163 {
+		eax, _, _, _ := cpuid(1, 0)
+		family := eax >> 8 & 0xF
+		if family == 0xF {
+			family += eax >> 20 & 0xFF
+		}
+		return family >= 0x19
+	}
+	return true
+}
+
+// Selects the PEXT slider lookups, if enabled and supported by the CPU, or the magic
+// ones otherwise. Returns whether PEXT is used. PEXT is selected at startup when
+// supported; this is meant for benchmarks and tests, and must not be called while
+// moves are being generated.
+func UsePEXT(enabled bool) bool {
+	usePEXT = enabled && hasPEXT
+	return usePEXT
+}
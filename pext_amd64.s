@@ -0,0 +1,40 @@
+#include "textflag.h"
+
+// func cpuid(eaxArg uint32, ecxArg uint32) (eax uint32, ebx uint32, ecx uint32, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func pextRookAttacks(square uint8, allPieces uint64) uint64
+TEXT ·pextRookAttacks(SB), NOSPLIT, $0-24
+	MOVBQZX square+0(FP), AX
+	MOVQ    allPieces+8(FP), BX
+	LEAQ    ·magicRookBlockerMasks(SB), CX
+	PEXTQ   (CX)(AX*8), BX, DX
+	LEAQ    ·pextRookOffsets(SB), CX
+	MOVLQZX (CX)(AX*4), CX
+	ADDQ    CX, DX
+	LEAQ    ·pextRookTable(SB), CX
+	MOVQ    (CX)(DX*8), AX
+	MOVQ    AX, ret+16(FP)
+	RET
+
+// func pextBishopAttacks(square uint8, allPieces uint64) uint64
+TEXT ·pextBishopAttacks(SB), NOSPLIT, $0-24
+	MOVBQZX square+0(FP), AX
+	MOVQ    allPieces+8(FP), BX
+	LEAQ    ·magicBishopBlockerMasks(SB), CX
+	PEXTQ   (CX)(AX*8), BX, DX
+	LEAQ    ·pextBishopOffsets(SB), CX
+	MOVLQZX (CX)(AX*4), CX
+	ADDQ    CX, DX
+	LEAQ    ·pextBishopTable(SB), CX
+	MOVQ    (CX)(DX*8), AX
+	MOVQ    AX, ret+16(FP)
+	RET
@@ -0,0 +1,23 @@
+//go:build !amd64
+
+package dragontoothmg
+
+// PEXT is only available on amd64; the magic lookups are always used elsewhere.
+
+const hasPEXT = false
+const usePEXT = false
+
+func pextRookAttacks(square uint8, allPieces uint64) uint64 {
+	panic("dragontoothmg: PEXT is not supported")
+}
+
+func pextBishopAttacks(square uint8, allPieces uint64) uint64 {
+	panic("dragontoothmg: PEXT is not supported")
+}
+
+// Selects the PEXT slider lookups, if enabled and supported by the CPU, or the magic
+// ones otherwise. Returns whether PEXT is used, which is never the case on this
+// architecture.
+func UsePEXT(enabled bool) bool {
+	return false
+}
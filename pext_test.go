@@ -0,0 +1,58 @@
+package dragontoothmg
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// Cross-checks the PEXT and magic slider lookups on every square and every subset of
+// the blocker masks, with and without random pieces outside of the masks.
+func TestPextAttacks(t *testing.T) {
+	if !hasPEXT {
+		t.Skip("PEXT is not supported by this CPU")
+	}
+	sliders := []struct {
+		name     string
+		masks    *[64]uint64
+		magic    func(uint8, uint64) uint64
+		pext     func(uint8, uint64) uint64
+		expected func(Square, uint64) uint64
+	}{
+		{"rook", &magicRookBlockerMasks, magicRookAttacks, pextRookAttacks, rookMovesFromBlockers},
+		{"bishop", &magicBishopBlockerMasks, magicBishopAttacks, pextBishopAttacks, bishopMovesFromBlockers},
+	}
+	r := rand.New(rand.NewSource(49))
+	for _, slider := range sliders {
+		for square := uint8(0); square < 64; square++ {
+			mask := slider.masks[square]
+			for blockers := uint64(0); ; blockers = (blockers - mask) & mask {
+				expected := slider.expected(Square(square), blockers)
+				outside := r.Uint64() &^ mask
+				if slider.magic(square, blockers) != expected || slider.pext(square, blockers) != expected ||
+					slider.pext(square, blockers|outside) != expected {
+					t.Fatal("Wrong", slider.name, "attacks from", square, "with blockers", blockers)
+				}
+				if blockers == mask {
+					break
+				}
+			}
+		}
+	}
+}
+
+// Both lookups give the same perft results.
+func TestPextPerft(t *testing.T) {
+	if !hasPEXT {
+		t.Skip("PEXT is not supported by this CPU")
+	}
+	defer UsePEXT(true)
+	b := ParseFen("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0")
+	for _, enabled := range []bool{false, true} {
+		if UsePEXT(enabled) != enabled {
+			t.Fatal("PEXT was not selected")
+		}
+		if nodes := Perft(&b, 3); nodes != 97862 {
+			t.Error("Perft with PEXT", enabled, "expected 97862, got", nodes)
+		}
+	}
+}
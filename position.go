@@ -0,0 +1,206 @@
+package dragontoothmg
+
+// Copy-make support. A Position is a compact value holding only the state of
+// the board itself, without the move history, so playing a move returns a new
+// position instead of changing the board. Positions can be copied freely, and
+// shared between goroutines. Only standard chess is supported.
+
+import "math/bits"
+
+// A chess position, as a value type for copy-make. It uses the same
+// little-endian rank-file mapping as the Board.
+type Position struct {
+	White         Bitboards
+	Black         Bitboards
+	hash          uint64
+	Fullmoveno    uint16
+	Halfmoveclock uint8
+	Wtomove       bool
+	enpassant     uint8 // square id (16-23 or 40-47) where en passant capture is possible
+	castlerights  uint8 // same layout as in the Board
+}
+
+// The castling right lost when the rook leaves (or is captured on) its corner.
+var rookCastleRights = [64]uint8{0: 1, 7: 1 << 1, 56: 1 << 2, 63: 1 << 3}
+
+// Returns the position of the board. The variant state and the history are dropped.
+func (b *Board) Position() Position {
+	return Position{
+		White:         b.White,
+		Black:         b.Black,
+		hash:          b.hash,
+		Fullmoveno:    b.Fullmoveno,
+		Halfmoveclock: b.Halfmoveclock,
+		Wtomove:       b.Wtomove,
+		enpassant:     b.enpassant,
+		castlerights:  b.castlerights,
+	}
+}
+
+// Returns a standard chess board in the position, with an empty history.
+func (p Position) Board() Board {
+	b := p.board()
+	b.SyncMailbox()
+	b.pawnHash = recomputePawnHash(&b)
+	b.materialKey = recomputeMaterialKey(&b)
+	b.History = make([]History, 1, 32)
+	b.History[0].hashCurrent = b.hash
+	return b
+}
+
+// Returns a board sharing the state of the position, enough to generate moves.
+func (p *Position) board() Board {
+	return Board{
+		Wtomove:       p.Wtomove,
+		enpassant:     p.enpassant,
+		castlerights:  p.castlerights,
+		Halfmoveclock: p.Halfmoveclock,
+		Fullmoveno:    p.Fullmoveno,
+		White:         p.White,
+		Black:         p.Black,
+		hash:          p.hash,
+	}
+}
+
+// Return the Zobrist hash value of the position, equal to the hash of the Board.
+func (p *Position) Hash() uint64 {
+	return p.hash
+}
+
+// Generates all legal moves in the position.
+func (p *Position) GenerateLegalMoves() []Move {
+	return p.GenerateMovesForPiece(Nothing)
+}
+
+// Generates the legal moves for given piece type.
+func (p *Position) GenerateMovesForPiece(piece Piece) []Move {
+	b := p.board()
+	moves := make([]Move, 0, b.getMoveListLength(piece))
+	b.standardMoves(&moves, piece)
+	return moves
+}
+
+// Returns the number of legal moves, without generating them when possible.
+func (p *Position) CountLegalMoves() int {
+	b := p.board()
+	return b.standardMoveCount()
+}
+
+// Returns whether the side to move is in check.
+func (p *Position) OurKingInCheck() bool {
+	b := p.board()
+	return b.standardKingInCheck()
+}
+
+// Returns the FEN string of the position.
+func (p *Position) ToFen() string {
+	b := p.board()
+	return b.ToFen()
+}
+
+// Returns the position after playing a move, leaving p unchanged. The move is
+// assumed to be legal (i.e., in the set of moves found by GenerateLegalMoves()).
+func (p Position) Play(m Move) Position {
+	ourPieces, oppPieces := &p.White, &p.Black
+	// indexes into pieceSquareZobristC of the pawns of each side
+	ourZobristIndex, oppZobristIndex := 0, 6
+	ourRights, oppRights := uint8(0x3), uint8(0xc)
+	var epDelta int8 = -8 // add this to the e.p. square to find the captured pawn
+	if !p.Wtomove {
+		ourPieces, oppPieces = oppPieces, ourPieces
+		ourZobristIndex, oppZobristIndex = oppZobristIndex, ourZobristIndex
+		ourRights, oppRights = oppRights, ourRights
+		epDelta = 8
+		p.Fullmoveno++ // increment after black's move
+	}
+	from, to := m.From(), m.To()
+	fromBitboard, toBitboard := uint64(1)<<from, uint64(1)<<to
+	piece, pieceBitboard := DeterminePieceType(ourPieces, fromBitboard)
+	captured, capturedBitboard := DeterminePieceType(oppPieces, toBitboard)
+
+	if captured != Nothing || piece == Pawn {
+		p.Halfmoveclock = 0
+	} else {
+		p.Halfmoveclock++
+	}
+
+	// Castling rights are lost by moving the king or a rook, or losing a rook
+	var lostRights uint8
+	switch piece {
+	case King:
+		lostRights = ourRights
+	case Rook:
+		lostRights = rookCastleRights[from] & ourRights
+	}
+	if captured == Rook {
+		lostRights |= rookCastleRights[to] & oppRights
+	}
+	lostRights &= p.castlerights
+	p.castlerights &^= lostRights
+	for ; lostRights != 0; lostRights &= lostRights - 1 {
+		// the Zobrist constants list kingside before queenside
+		p.hash ^= castleRightsZobristC[bits.TrailingZeros8(lostRights)^1]
+	}
+
+	// Move the castling rook
+	if piece == King && (to-from == 2 || int(to)-int(from) == -2) {
+		oldRookLoc, newRookLoc := to+1, to-1
+		if to < from { // castle long
+			oldRookLoc, newRookLoc = to-2, to+1
+		}
+		ourPieces.Rooks ^= uint64(1)<<oldRookLoc | uint64(1)<<newRookLoc
+		ourPieces.All ^= uint64(1)<<oldRookLoc | uint64(1)<<newRookLoc
+		p.hash ^= pieceSquareZobristC[ourZobristIndex+(Rook-1)][oldRookLoc]
+		p.hash ^= pieceSquareZobristC[ourZobristIndex+(Rook-1)][newRookLoc]
+	}
+
+	// Capture en passant, and update the en passant square
+	oldEpCaptureSquare := p.enpassant
+	if piece == Pawn && to == oldEpCaptureSquare && oldEpCaptureSquare != 0 {
+		epOpponentPawnLocation := uint8(int8(oldEpCaptureSquare) + epDelta)
+		oppPieces.Pawns &^= uint64(1) << epOpponentPawnLocation
+		oppPieces.All &^= uint64(1) << epOpponentPawnLocation
+		p.hash ^= pieceSquareZobristC[oppZobristIndex][epOpponentPawnLocation]
+	}
+	if piece == Pawn && int8(to)+2*epDelta == int8(from) { // pawn double push
+		p.enpassant = uint8(int8(to) + epDelta)
+	} else {
+		p.enpassant = 0
+	}
+	p.hash ^= uint64(oldEpCaptureSquare) ^ uint64(p.enpassant)
+
+	// Move the piece, promoting it if needed
+	if captured != Nothing {
+		*capturedBitboard &^= toBitboard
+		oppPieces.All &^= toBitboard
+		p.hash ^= pieceSquareZobristC[oppZobristIndex+int(captured)-1][to]
+	}
+	*pieceBitboard &^= fromBitboard
+	p.hash ^= pieceSquareZobristC[ourZobristIndex+int(piece)-1][from]
+	if promote := m.Promote(); promote != Nothing {
+		piece, pieceBitboard = promote, ourPieces.pieceBitboard(promote)
+	}
+	*pieceBitboard |= toBitboard
+	ourPieces.All ^= fromBitboard | toBitboard
+	p.hash ^= pieceSquareZobristC[ourZobristIndex+int(piece)-1][to]
+
+	p.hash ^= whiteToMoveZobristC
+	p.Wtomove = !p.Wtomove
+	return p
+}
+
+// Perft on a position, playing the moves by copy-make.
+func PerftPosition(p Position, n int) int64 {
+	if n <= 0 {
+		return 1
+	}
+	if n == 1 {
+		return int64(p.CountLegalMoves())
+	}
+	moves := p.GenerateLegalMoves()
+	var count int64 = 0
+	for _, move := range moves {
+		count += PerftPosition(p.Play(move), n-1)
+	}
+	return count
+}
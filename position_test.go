@@ -0,0 +1,73 @@
+package dragontoothmg
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPositionPerft(t *testing.T) {
+	tests := []struct {
+		fen   string
+		depth int
+		nodes int64
+	}{
+		{Startpos, 5, 4865609},
+		{"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0", 4, 4085603},
+		{"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 0", 5, 674624},
+		{"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1", 4, 422333},
+	}
+	for _, test := range tests {
+		b := ParseFen(test.fen)
+		if nodes := PerftPosition(b.Position(), test.depth); nodes != test.nodes {
+			t.Error("Position perft", test.depth, "of", test.fen, "expected", test.nodes, "got", nodes)
+		}
+	}
+}
+
+// Plays random games both ways, comparing the positions and their hashes
+func TestPositionPlay(t *testing.T) {
+	rng := rand.New(rand.NewSource(40))
+	for game := 0; game < 50; game++ {
+		b := ParseFen("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0")
+		p := b.Position()
+		for ply := 0; ply < 100; ply++ {
+			moves := b.GenerateLegalMoves()
+			if len(moves) == 0 {
+				break
+			}
+			m := moves[rng.Intn(len(moves))]
+			before := p
+			p = p.Play(m)
+			b.Make(m)
+			if p != b.Position() || p.Hash() != b.Hash() {
+				t.Fatal("Position differs after", m.String(), "\n", p.ToFen(), "\n", b.ToFen())
+			}
+			if before.Play(m) != p {
+				t.Fatal("Play changed the original position")
+			}
+		}
+	}
+}
+
+func TestPositionConversion(t *testing.T) {
+	b := ParseFen("rnbqkbnr/ppp1pppp/8/8/2Pp4/8/PP1PPPPP/RNBQKBNR b KQkq c3 0 3")
+	p := b.Position()
+	if p.ToFen() != b.ToFen() {
+		t.Error("Wrong position fen", p.ToFen())
+	}
+	converted := p.Board()
+	if converted.ToFen() != b.ToFen() || converted.Hash() != b.Hash() ||
+		converted.PawnHash() != b.PawnHash() || converted.MaterialKey() != b.MaterialKey() {
+		t.Error("Board conversion lost state:", converted.ToFen())
+	}
+	if !mailboxInSync(&converted) {
+		t.Error("Board conversion didn't build the mailbox")
+	}
+	converted.Make(parseMove("d4c3"))
+	if converted.Position() != p.Play(parseMove("d4c3")) {
+		t.Error("Converted board played differently")
+	}
+	if len(p.GenerateLegalMoves()) != len(b.GenerateLegalMoves()) || p.OurKingInCheck() {
+		t.Error("Position generated different moves")
+	}
+}
@@ -0,0 +1,118 @@
+package dragontoothmg
+
+// Pluggable variant rules. A board is created with the rules of its variant,
+// which hook into move generation, Make and Undo, termination, insufficient
+// material, the Zobrist hash and FEN. Standard chess is the default: boards
+// with the standard rules store no rules at all, so the move generator, Make and
+// Undo take their fast path with a single nil check, whose cost doesn't show in
+// the perft benchmarks.
+
+// The rules of a chess variant. The rules hold no state of their own: the state of
+// the variant (pockets, checks left, ...) is part of the board. Variants embed
+// standardRules, and override the behavior that differs from standard chess.
+type Rules interface {
+	// The variant implemented by the rules
+	Variant() Variant
+	// The FEN of the starting position
+	Startpos() string
+
+	// Generates the legal moves, including extra move kinds such as drops.
+	// If piece is not Nothing, only moves of that piece are generated.
+	GenerateMoves(b *Board, piece Piece) []Move
+	// Returns whether the side to move is in check.
+	InCheck(b *Board) bool
+
+	// Called by Make after an ordinary (non-drop) move was made, the side to move
+	// flipped and its history entry h appended. The captured piece includes en
+	// passant captures (Nothing if none). Side effects on the board are recorded
+	// in h, and their hash updates made on the board.
+	Make(b *Board, h *History, captured Piece)
+	// Called first by Undo of an ordinary move, to revert the side effects of Make.
+	// The hashes are restored by Undo.
+	Undo(b *Board, h *History)
+
+	// Returns how the game ended by the rules of the variant, given the number
+	// of legal moves, or TerminationNone to apply checkmate and stalemate.
+	Termination(b *Board, moveCount int) Termination
+	// Returns whether neither side can win anymore.
+	InsufficientMaterial(b *Board) bool
+
+	// Returns the Zobrist key of the variant state of the board.
+	Hash(b *Board) uint64
+	// Reads the FEN extensions of the variant from the FEN fields, and returns
+	// the standard fields (piece placement, side to move, castling, en passant,
+	// clocks) left to parse.
+	ParseFen(b *Board, fields []string) []string
+	// Adds the FEN extensions of the variant to the standard FEN fields.
+	FormatFen(b *Board, fields []string) []string
+}
+
+// Returns the rules of the variant.
+func (v Variant) Rules() Rules {
+	switch v {
+	case VariantCrazyhouse:
+		return crazyhouseRules{}
+	case VariantAtomic:
+		return atomicRules{}
+	case VariantAntichess:
+		return antichessRules{}
+	case VariantThreeCheck:
+		return threeCheckRules{}
+	case VariantKingOfTheHill:
+		return kingOfTheHillRules{}
+	case VariantRacingKings:
+		return racingKingsRules{}
+	case VariantHorde:
+		return hordeRules{}
+	}
+	return standardRules{}
+}
+
+// Returns the rules the board was created with.
+func (b *Board) Rules() Rules {
+	if b.rules == nil {
+		return standardRules{}
+	}
+	return b.rules
+}
+
+// Returns the variant played on the board.
+func (b *Board) Variant() Variant {
+	if b.rules == nil {
+		return VariantStandard
+	}
+	return b.rules.Variant()
+}
+
+// The rules of standard chess, also the defaults of the other variants.
+type standardRules struct{}
+
+func (standardRules) Variant() Variant { return VariantStandard }
+func (standardRules) Startpos() string { return Startpos }
+
+func (standardRules) GenerateMoves(b *Board, piece Piece) []Move {
+	moves := make([]Move, 0, b.getMoveListLength(piece))
+	b.standardMoves(&moves, piece)
+	return moves
+}
+
+func (standardRules) InCheck(b *Board) bool { return b.standardKingInCheck() }
+
+func (standardRules) Make(b *Board, h *History, captured Piece) {}
+func (standardRules) Undo(b *Board, h *History)                 {}
+
+func (standardRules) Termination(b *Board, moveCount int) Termination { return TerminationNone }
+func (standardRules) InsufficientMaterial(b *Board) bool              { return b.standardInsufficientMaterial() }
+
+func (standardRules) Hash(b *Board) uint64                         { return 0 }
+func (standardRules) ParseFen(b *Board, fields []string) []string  { return fields }
+func (standardRules) FormatFen(b *Board, fields []string) []string { return fields }
+
+// Returns the rules to store in a board: nil for standard chess, so that it
+// takes the fast path.
+func boardRules(rules Rules) Rules {
+	if _, ok := rules.(standardRules); ok {
+		return nil
+	}
+	return rules
+}
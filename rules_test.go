@@ -0,0 +1,28 @@
+package dragontoothmg
+
+import "testing"
+
+func TestVariantRules(t *testing.T) {
+	variants := []Variant{VariantStandard, VariantCrazyhouse, VariantAtomic, VariantAntichess,
+		VariantThreeCheck, VariantKingOfTheHill, VariantRacingKings, VariantHorde}
+	for _, v := range variants {
+		if v.Rules().Variant() != v {
+			t.Error("Wrong rules for", v)
+		}
+		b := NewBoardRules(v.Rules())
+		if b.Variant() != v || b.Rules().Variant() != v {
+			t.Error("Board created with the wrong rules for", v)
+		}
+		if b.ToFen() != v.Startpos() {
+			t.Error("Wrong starting position for", v, b.ToFen())
+		}
+		if b.Hash() != recomputeBoardHash(b) {
+			t.Error("Hash mismatch in the starting position of", v)
+		}
+	}
+
+	// Standard chess keeps the fast path
+	if b := ParseFenRules(Startpos, VariantStandard.Rules()); b.rules != nil {
+		t.Error("Standard rules should not be stored in the board")
+	}
+}
@@ -0,0 +1,80 @@
+package dragontoothmg
+
+// Static exchange evaluation (SEE): the material balance of the captures and
+// recaptures on one square, where each side always recaptures with its least
+// valuable piece, and may stop capturing when it is ahead. Pins are ignored.
+
+import "math/bits"
+
+// Piece values used by the static exchange evaluation, in centipawns, indexed by Piece.
+var SeeValues = [King + 1]int{0, 100, 300, 300, 500, 900, 10000}
+
+// Returns the material gained by the side to move by making the move and the best
+// sequence of recaptures on its target square, in centipawns (see SeeValues).
+// Quiet moves return 0 or less, if the moved piece can be won. The move is assumed
+// to be pseudo-legal. Only the standard rules are used, whatever the variant.
+func (b *Board) SEE(m Move) int {
+	if m.IsDrop() {
+		return 0
+	}
+	from, to := m.From(), m.To()
+	toBitboard := uint64(1) << to
+	occupied := (b.White.All | b.Black.All) &^ (uint64(1) << from)
+	captured := Piece(b.mailbox[to] & 7)
+	attacker := Piece(b.mailbox[from] & 7)
+	if attacker == Pawn && to == b.enpassant && b.enpassant != 0 {
+		captured = Pawn
+		if b.Wtomove {
+			occupied &^= toBitboard >> 8
+		} else {
+			occupied &^= toBitboard << 8
+		}
+	}
+
+	var gain [32]int
+	gain[0] = SeeValues[captured]
+	if promote := m.Promote(); promote != Nothing {
+		gain[0] += SeeValues[promote] - SeeValues[Pawn]
+		attacker = promote
+	}
+	attackers := b.AttackersTo(Square(to), occupied) & occupied
+	white := !b.Wtomove // the side recapturing
+	depth := 0
+	for depth < len(gain)-1 {
+		sidePieces := &(b.White)
+		if !white {
+			sidePieces = &(b.Black)
+		}
+		sideAttackers := attackers & sidePieces.All
+		if sideAttackers == 0 {
+			break
+		}
+		// Recapture with the least valuable piece
+		piece, pieceBitboard := leastValuableAttacker(sidePieces, sideAttackers)
+		if piece == King && attackers&^sidePieces.All != 0 {
+			break // the king can't recapture into check
+		}
+		depth++
+		gain[depth] = SeeValues[attacker] - gain[depth-1]
+		attacker = piece
+		occupied &^= pieceBitboard
+		// Sliders behind the capturing piece join in
+		attackers |= b.AttackersTo(Square(to), occupied)
+		attackers &= occupied
+		white = !white
+	}
+	for ; depth > 0; depth-- {
+		gain[depth-1] = -max(-gain[depth-1], gain[depth])
+	}
+	return gain[0]
+}
+
+// Returns the least valuable piece among the attackers, and its square as a bitboard.
+func leastValuableAttacker(pieces *Bitboards, attackers uint64) (Piece, uint64) {
+	for piece := Piece(Pawn); piece <= King; piece++ {
+		if found := *pieces.pieceBitboard(piece) & attackers; found != 0 {
+			return piece, uint64(1) << bits.TrailingZeros64(found)
+		}
+	}
+	return Nothing, 0
+}
@@ -0,0 +1,40 @@
+package dragontoothmg
+
+import "testing"
+
+func TestSEE(t *testing.T) {
+	tests := []struct {
+		fen  string
+		move string
+		see  int
+	}{
+		// undefended pawn
+		{"1k1r4/1pp4p/p7/4p3/8/P5P1/1PP4P/2K1R3 w - - 0 1", "e1e5", 100},
+		// knight for pawn, after the whole exchange with x-rays on both sides
+		{"1k1r3q/1ppn3p/p4b2/4p3/8/P2N2P1/1PP1R1BP/2K1Q3 w - - 0 1", "d3e5", -200},
+		// defended pawn taken by a pawn
+		{"4k3/8/3p4/4p3/3P4/8/8/4K3 w - - 0 1", "d4e5", 0},
+		// queen takes a defended pawn
+		{"4k3/8/3p4/4p3/8/8/4Q3/4K3 w - - 0 1", "e2e5", -800},
+		// en passant
+		{"4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1", "e5d6", 100},
+		// capture-promotion, recaptured by the king
+		{"3rk3/2P5/8/8/8/8/8/4K3 w - - 0 1", "c7d8q", 500 + 800 - 900},
+		// the king can't recapture a defended piece
+		{"4k3/8/3p4/8/4N3/8/8/3RK3 w - - 0 1", "d1d6", 100},
+		{"3rk3/8/3p4/8/4N3/8/8/3RK3 w - - 0 1", "d1d6", 100},
+		// quiet move to an attacked square
+		{"4k3/8/3p4/8/8/8/8/2B1K3 w - - 0 1", "c1e3", 0},
+		{"4k3/8/3p4/8/8/8/8/2B1K3 w - - 0 1", "c1f4", 0},
+		{"4k3/8/8/3p4/8/8/8/2N1K3 w - - 0 1", "c1e2", 0},
+		{"4k3/8/8/3p4/8/8/8/3NK3 w - - 0 1", "d1c3", 0},
+		{"4k3/8/8/3p4/8/8/8/3NK3 w - - 0 1", "d1e3", 0},
+		{"4k3/8/8/3p4/8/8/3N4/4K3 w - - 0 1", "d2c4", -300},
+	}
+	for _, test := range tests {
+		b := ParseFen(test.fen)
+		if see := b.SEE(parseMove(test.move)); see != test.see {
+			t.Error("SEE of", test.move, "in", test.fen, "expected", test.see, "got", see)
+		}
+	}
+}
@@ -0,0 +1,326 @@
+package syzygy
+
+// Index computation for Syzygy tables. A position is mapped to an index by
+// grouping its pieces (leading pawns or pieces first), reducing the position
+// by symmetry and encoding each group with binomial coefficients.
+// The tables below are filled once, at package initialization.
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/IlikeChooros/dragontoothmg"
+)
+
+const tbPieces = 7 // Largest supported number of pieces, kings included
+
+var mapPawns [64]int
+var mapB1H1H7 [64]int
+var mapA1D1D4 [64]int
+var mapKK [10][64]int // [mapA1D1D4][square]
+
+var binomial [6][64]uint64     // [k][n] ways to choose k elements from a set of n
+var leadPawnIdx [6][64]uint64  // [leadPawnsCnt][square]
+var leadPawnsSize [6][4]uint64 // [leadPawnsCnt][file a..d]
+var kingAttacks [64]uint64     // king neighbourhood, used to skip illegal king pairs
+
+func init() {
+	for sq := 0; sq < 64; sq++ {
+		for dr := -1; dr <= 1; dr++ {
+			for df := -1; df <= 1; df++ {
+				r, f := sq/8+dr, sq%8+df
+				if (dr != 0 || df != 0) && r >= 0 && r < 8 && f >= 0 && f < 8 {
+					kingAttacks[sq] |= uint64(1) << (r*8 + f)
+				}
+			}
+		}
+	}
+
+	// mapB1H1H7 encodes a square below the a1-h8 diagonal to 0..27
+	code := 0
+	for sq := 0; sq < 64; sq++ {
+		if offA1H8(sq) < 0 {
+			mapB1H1H7[sq] = code
+			code++
+		}
+	}
+
+	// mapA1D1D4 encodes a square in the a1-d1-d4 triangle to 0..9,
+	// with the squares of the diagonal encoded last
+	var diagonal []int
+	code = 0
+	for sq := 0; sq <= 27; sq++ {
+		if offA1H8(sq) < 0 && sq%8 <= 3 {
+			mapA1D1D4[sq] = code
+			code++
+		} else if offA1H8(sq) == 0 && sq%8 <= 3 {
+			diagonal = append(diagonal, sq)
+		}
+	}
+	for _, sq := range diagonal {
+		mapA1D1D4[sq] = code
+		code++
+	}
+
+	// mapKK encodes the 462 legal placements of two kings where the first one
+	// is in the a1-d1-d4 triangle. If the first king is on the a1-d4 diagonal,
+	// the other one is not above the a1-h8 diagonal.
+	type kingPair struct{ idx, sq int }
+	var bothOnDiagonal []kingPair
+	code = 0
+	for idx := 0; idx < 10; idx++ {
+		for s1 := 0; s1 <= 27; s1++ {
+			if mapA1D1D4[s1] != idx || (idx == 0 && s1 != 1) { // b1 is mapped to 0
+				continue
+			}
+			for s2 := 0; s2 < 64; s2++ {
+				if (kingAttacks[s1]|uint64(1)<<s1)&(uint64(1)<<s2) != 0 {
+					continue // illegal position
+				} else if offA1H8(s1) == 0 && offA1H8(s2) > 0 {
+					continue // first on the diagonal, second above
+				} else if offA1H8(s1) == 0 && offA1H8(s2) == 0 {
+					bothOnDiagonal = append(bothOnDiagonal, kingPair{idx, s2})
+				} else {
+					mapKK[idx][s2] = code
+					code++
+				}
+			}
+		}
+	}
+	for _, p := range bothOnDiagonal {
+		mapKK[p.idx][p.sq] = code
+		code++
+	}
+
+	// Binomial coefficients, using Pascal's rule
+	binomial[0][0] = 1
+	for n := 1; n < 64; n++ {
+		for k := 0; k < 6 && k <= n; k++ {
+			if k > 0 {
+				binomial[k][n] += binomial[k-1][n-1]
+			}
+			if k < n {
+				binomial[k][n] += binomial[k][n-1]
+			}
+		}
+	}
+
+	// mapPawns encodes the squares a2-h7 to 0..47: the number of squares still
+	// available to the other pawns when the leading pawn stands on the square.
+	// The leading pawn is the one with the highest value, i.e. the one closest
+	// to the edge and, among pawns on the same file, the one with the lowest rank.
+	availableSquares := 47
+	for leadPawnsCnt := 1; leadPawnsCnt <= 5; leadPawnsCnt++ {
+		for f := 0; f <= 3; f++ {
+			// The table is split by file, so the index restarts at every file
+			var idx uint64 = 0
+			for r := 1; r <= 6; r++ {
+				sq := r*8 + f
+				if leadPawnsCnt == 1 {
+					mapPawns[sq] = availableSquares
+					availableSquares--
+					mapPawns[sq^7] = availableSquares
+					availableSquares--
+				}
+				leadPawnIdx[leadPawnsCnt][sq] = idx
+				idx += binomial[leadPawnsCnt-1][mapPawns[sq]]
+			}
+			leadPawnsSize[leadPawnsCnt][f] = idx
+		}
+	}
+}
+
+// Returns a positive number for squares above the a1-h8 diagonal,
+// a negative one for squares below it, and zero on the diagonal.
+func offA1H8(sq int) int {
+	return sq/8 - sq%8
+}
+
+// Distance of a file from the nearest edge of the board.
+func edgeDistance(file int) int {
+	return min(file, 7-file)
+}
+
+// Returns the piece on the square, using the Syzygy piece codes:
+// 1-6 for white pieces (pawn to king) and 9-14 for black ones.
+func pieceCode(b *dragontoothmg.Board, sq int) uint8 {
+	piece, isWhite := dragontoothmg.GetPieceType(uint8(sq), b)
+	if isWhite {
+		return uint8(piece)
+	}
+	return uint8(piece) | 8
+}
+
+// Computes the index of the position in the table. Returns the pairs data
+// record to decompress, the file of the leading pawn (0 without pawns), and
+// whether the position belongs to the side that is not stored (DTZ only).
+//
+// Given a group of k identical pieces sorted by square s1 < s2 < ... < sk,
+// the group is encoded as binomial[1][s1] + binomial[2][s2] + ... + binomial[k][sk].
+func (t *table) index(b *dragontoothmg.Board) (d *pairsData, file int, idx uint64, changeStm bool) {
+	var squares [tbPieces]int
+	var pieces [tbPieces]uint8
+	var leadPawns uint64
+	size, leadPawnsCnt := 0, 0
+
+	// If both sides have the same pieces, the table only stores the white to
+	// move case; positions with black to move are looked up with colors switched.
+	// Tables are stored with the stronger side as white: if black is stronger,
+	// colors are switched as well.
+	flip := (t.key == t.key2 && !b.Wtomove) || b.MaterialSignature() != t.key
+	flipColor, flipSquares, stm := uint8(0), 0, 0
+	if flip {
+		flipColor, flipSquares = 8, 56
+	}
+	if flip == b.Wtomove {
+		stm = 1
+	}
+
+	// With pawns, the table is split in four by the file of the leading pawn.
+	// Pawns of the leading color always come first in the piece sequence.
+	if t.hasPawns {
+		if t.items[0][0].pieces[0]^flipColor < 8 {
+			leadPawns = b.White.Pawns
+		} else {
+			leadPawns = b.Black.Pawns
+		}
+		for bb := leadPawns; bb != 0; bb &= bb - 1 {
+			squares[size] = bits.TrailingZeros64(bb) ^ flipSquares
+			size++
+		}
+		leadPawnsCnt = size
+		lead := 0
+		for i := 1; i < leadPawnsCnt; i++ {
+			if mapPawns[squares[i]] > mapPawns[squares[lead]] {
+				lead = i
+			}
+		}
+		squares[0], squares[lead] = squares[lead], squares[0]
+		file = edgeDistance(squares[0] % 8)
+	}
+
+	// DTZ tables only store positions for one side to move
+	if t.kind == dtzTable && !t.storesSide(stm, file) {
+		return nil, file, 0, true
+	}
+
+	for bb := (b.White.All | b.Black.All) &^ leadPawns; bb != 0; bb &= bb - 1 {
+		sq := bits.TrailingZeros64(bb)
+		squares[size] = sq ^ flipSquares
+		pieces[size] = pieceCode(b, sq) ^ flipColor
+		size++
+	}
+
+	d = t.get(stm, file)
+
+	// Reorder the pieces to follow the sequence stored in the table
+	for i := leadPawnsCnt; i < size-1; i++ {
+		for j := i + 1; j < size; j++ {
+			if d.pieces[i] == pieces[j] {
+				pieces[i], pieces[j] = pieces[j], pieces[i]
+				squares[i], squares[j] = squares[j], squares[i]
+				break
+			}
+		}
+	}
+
+	// Mirror the board so that the leading piece is on files a-d
+	if squares[0]%8 > 3 {
+		for i := 0; i < size; i++ {
+			squares[i] ^= 7
+		}
+	}
+
+	if t.hasPawns {
+		idx = leadPawnIdx[leadPawnsCnt][squares[0]]
+		others := squares[1:leadPawnsCnt]
+		sort.SliceStable(others, func(i, j int) bool {
+			return mapPawns[others[i]] < mapPawns[others[j]]
+		})
+		for i := 1; i < leadPawnsCnt; i++ {
+			idx += binomial[i][mapPawns[squares[i]]]
+		}
+	} else {
+		// Without pawns, the leading piece is also mirrored below rank 5 ...
+		if squares[0]/8 > 3 {
+			for i := 0; i < size; i++ {
+				squares[i] ^= 56
+			}
+		}
+		// ... and the first piece of the leading group not on the a1-h8
+		// diagonal is mirrored below it.
+		for i := 0; i < d.groupLen[0]; i++ {
+			if offA1H8(squares[i]) == 0 {
+				continue
+			}
+			if offA1H8(squares[i]) > 0 {
+				for j := i; j < size; j++ {
+					squares[j] = ((squares[j] >> 3) | (squares[j] << 3)) & 63
+				}
+			}
+			break
+		}
+		idx = t.encodeLeadingPieces(squares[:size])
+	}
+
+	// Encode the remaining pawns, then the remaining pieces, group by group
+	idx *= d.groupIdx[0]
+	groupStart := d.groupLen[0]
+	remainingPawns := t.hasPawns && t.pawnCount[1] != 0
+	for next := 1; d.groupLen[next] != 0; next++ {
+		group := squares[groupStart : groupStart+d.groupLen[next]]
+		sort.Ints(group)
+		var n uint64 = 0
+		for i, sq := range group {
+			// Squares of the previous groups are not available
+			adjust := 0
+			for _, s := range squares[:groupStart] {
+				if sq > s {
+					adjust++
+				}
+			}
+			if remainingPawns {
+				adjust += 8
+			}
+			n += binomial[i+1][sq-adjust]
+		}
+		remainingPawns = false
+		idx += n * d.groupIdx[next]
+		groupStart += d.groupLen[next]
+	}
+	return d, file, idx, false
+}
+
+// Encodes the leading group of a pawnless table: three unique pieces
+// together if the table has any, otherwise the two kings.
+func (t *table) encodeLeadingPieces(squares []int) uint64 {
+	if !t.hasUniquePieces {
+		return uint64(mapKK[mapA1D1D4[squares[0]]][squares[1]])
+	}
+	adjust1, adjust2 := 0, 0
+	if squares[1] > squares[0] {
+		adjust1 = 1
+	}
+	if squares[2] > squares[0] {
+		adjust2++
+	}
+	if squares[2] > squares[1] {
+		adjust2++
+	}
+	var idx int
+	if offA1H8(squares[0]) != 0 {
+		// First piece below the diagonal: mapA1D1D4 maps the b1-d1-d3 triangle
+		// to 0..5, and 63 and 62 squares remain for the other two pieces.
+		idx = (mapA1D1D4[squares[0]]*63+(squares[1]-adjust1))*62 + squares[2] - adjust2
+	} else if offA1H8(squares[1]) != 0 {
+		// First piece on the diagonal, second below it
+		idx = (6*63+(squares[0]/8)*28+mapB1H1H7[squares[1]])*62 + squares[2] - adjust2
+	} else if offA1H8(squares[2]) != 0 {
+		// First two pieces on the diagonal, third below it
+		idx = 6*63*62 + 4*28*62 + (squares[0]/8)*7*28 + (squares[1]/8-adjust1)*28 + mapB1H1H7[squares[2]]
+	} else {
+		// All three pieces on the diagonal
+		idx = 6*63*62 + 4*28*62 + 4*7*28 + (squares[0]/8)*7*6 + (squares[1]/8-adjust1)*6 + (squares[2]/8 - adjust2)
+	}
+	return uint64(idx)
+}
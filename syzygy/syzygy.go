@@ -0,0 +1,432 @@
+// Package syzygy probes Syzygy endgame tablebases (.rtbw and .rtbz files)
+// for dragontoothmg boards.
+//
+// WDL tables give the game theoretical value of a position, taking the
+// 50-move rule into account. DTZ tables give the distance, in plies, to the
+// next capture or pawn move (zeroing the 50-move counter) on an optimal path.
+//
+//	tb, err := syzygy.Open("/path/to/syzygy")
+//	wdl, err := tb.ProbeWDL(board)
+//	moves, err := tb.FilterRootMoves(board)
+//
+// Files are read into memory at their first probe.
+//
+// The reader has only been tested against tables written by the package's own
+// test encoder (see writer_test.go), not against official Syzygy tables yet.
+// Run the tests with SYZYGY_PATH set (see TestOfficialTables) before relying on it.
+package syzygy
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/IlikeChooros/dragontoothmg"
+)
+
+// Win/draw/loss value of a position, from the side to move's point of view.
+// A cursed win is a win that is a draw under the 50-move rule, a blessed
+// loss a loss that is saved by it.
+type WDL int8
+
+const (
+	Loss        WDL = -2
+	BlessedLoss WDL = -1
+	Draw        WDL = 0
+	CursedWin   WDL = 1
+	Win         WDL = 2
+)
+
+func (w WDL) String() string {
+	switch w {
+	case Loss:
+		return "Loss"
+	case BlessedLoss:
+		return "BlessedLoss"
+	case Draw:
+		return "Draw"
+	case CursedWin:
+		return "CursedWin"
+	case Win:
+		return "Win"
+	}
+	return fmt.Sprintf("WDL(%d)", int8(w))
+}
+
+var (
+	// The position has more pieces than the largest available table
+	ErrTooManyPieces = errors.New("syzygy: too many pieces")
+	// Tablebases do not contain positions with castling rights
+	ErrCastlingRights = errors.New("syzygy: position has castling rights")
+	// A table required to probe the position is not available
+	ErrMissingTable = errors.New("syzygy: missing table")
+)
+
+// A set of Syzygy tables, indexed by material signature. Safe for
+// concurrent use.
+type Tablebase struct {
+	wdl, dtz map[string]*table
+	// Largest number of pieces (kings included) of the available WDL tables
+	MaxPieces int
+}
+
+// Opens the tablebase files found in the given directories. Files are
+// only read when first probed.
+func Open(dirs ...string) (*Tablebase, error) {
+	tb := &Tablebase{wdl: map[string]*table{}, dtz: map[string]*table{}}
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("syzygy: %w", err)
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			tables, kind := tb.wdl, wdlTable
+			switch filepath.Ext(name) {
+			case ".rtbw":
+			case ".rtbz":
+				tables, kind = tb.dtz, dtzTable
+			default:
+				continue
+			}
+			signature := strings.TrimSuffix(name, filepath.Ext(name))
+			if _, ok := tables[signature]; ok {
+				continue // the first directory takes precedence
+			}
+			t, err := newTable(kind, filepath.Join(dir, name), signature)
+			if err != nil {
+				return nil, err
+			}
+			tables[t.key] = t
+			tables[t.key2] = t
+			if kind == wdlTable {
+				tb.MaxPieces = max(tb.MaxPieces, t.pieceCount)
+			}
+		}
+	}
+	return tb, nil
+}
+
+// Result of a table lookup, besides the value
+type probeState int
+
+const (
+	probeOk              probeState = iota
+	probeChangeStm                  // DTZ table stores the other side to move
+	probeZeroingBestMove            // Best move zeroes the 50-move counter
+)
+
+// Probes a single table, without searching captures.
+func (tb *Tablebase) probeTable(b *dragontoothmg.Board, kind tableKind, wdl WDL) (int, probeState, error) {
+	if bits.OnesCount64(b.White.All|b.Black.All) == 2 {
+		return int(Draw), probeOk, nil // KvK
+	}
+	tables := tb.wdl
+	if kind == dtzTable {
+		tables = tb.dtz
+	}
+	signature := b.MaterialSignature()
+	t, ok := tables[signature]
+	if !ok {
+		return 0, probeOk, fmt.Errorf("%w: %s", ErrMissingTable, signature)
+	}
+	if err := t.load(); err != nil {
+		return 0, probeOk, err
+	}
+	d, file, idx, changeStm := t.index(b)
+	if changeStm {
+		return 0, probeChangeStm, nil
+	}
+	value := d.decompress(t.data, idx)
+	if kind == wdlTable {
+		return value - 2, probeOk, nil
+	}
+	return t.mapDtz(file, value, wdl), probeOk, nil
+}
+
+// Whether the move is a capture or a pawn move.
+func isZeroing(b *dragontoothmg.Board, m dragontoothmg.Move) bool {
+	piece, _ := dragontoothmg.GetPieceType(m.From(), b)
+	return piece == dragontoothmg.Pawn || dragontoothmg.IsCapture(m, b)
+}
+
+// Tables may store arbitrary values for positions where the side to move has
+// a winning capture (and, for DTZ, a winning pawn move), and a loss instead of
+// a draw when a drawing capture exists. They never store positions with en
+// passant rights. So captures are searched, and the best result is kept.
+func (tb *Tablebase) search(b *dragontoothmg.Board, checkZeroingMoves bool) (WDL, probeState, error) {
+	bestValue := Loss
+	moves := b.GenerateLegalMoves()
+	moveCount := 0
+	for _, m := range moves {
+		capture := dragontoothmg.IsCapture(m, b)
+		if !capture && (!checkZeroingMoves || !isZeroing(b, m)) {
+			continue
+		}
+		moveCount++
+		b.Make(m)
+		value, _, err := tb.search(b, false)
+		b.Undo()
+		if err != nil {
+			return Draw, probeOk, err
+		}
+		value = -value
+		if value > bestValue {
+			bestValue = value
+			if value >= Win {
+				return value, probeZeroingBestMove, nil
+			}
+		}
+	}
+
+	// If all the moves were searched, the stored value can't be trusted
+	// (for instance, with en passant rights); use the search result.
+	noMoreMoves := moveCount != 0 && moveCount == len(moves)
+	var value WDL
+	if noMoreMoves {
+		value = bestValue
+	} else {
+		v, _, err := tb.probeTable(b, wdlTable, Draw)
+		if err != nil {
+			return Draw, probeOk, err
+		}
+		value = WDL(v)
+	}
+
+	if bestValue >= value {
+		if bestValue > Draw || noMoreMoves {
+			return bestValue, probeZeroingBestMove, nil
+		}
+		return bestValue, probeOk, nil
+	}
+	return value, probeOk, nil
+}
+
+// Checks that the position can be looked up.
+func (tb *Tablebase) checkPosition(b *dragontoothmg.Board) error {
+	if b.WhiteCanCastleKingside() || b.WhiteCanCastleQueenside() ||
+		b.BlackCanCastleKingside() || b.BlackCanCastleQueenside() {
+		return ErrCastlingRights
+	}
+	if bits.OnesCount64(b.White.All|b.Black.All) > tb.MaxPieces {
+		return ErrTooManyPieces
+	}
+	return nil
+}
+
+// Returns the win/draw/loss value of the position, for the side to move.
+// The board is modified during the probe, but restored before returning.
+func (tb *Tablebase) ProbeWDL(b *dragontoothmg.Board) (WDL, error) {
+	if err := tb.checkPosition(b); err != nil {
+		return Draw, err
+	}
+	wdl, _, err := tb.search(b, false)
+	return wdl, err
+}
+
+// DTZ of a position whose best move zeroes the 50-move counter
+func dtzBeforeZeroing(wdl WDL) int {
+	switch wdl {
+	case Win:
+		return 1
+	case CursedWin:
+		return 101
+	case BlessedLoss:
+		return -101
+	case Loss:
+		return -1
+	}
+	return 0
+}
+
+func sign(n int) int {
+	if n > 0 {
+		return 1
+	} else if n < 0 {
+		return -1
+	}
+	return 0
+}
+
+// Returns the distance to zeroing in plies: the number of plies until the
+// next capture or pawn move (or checkmate), assuming optimal play.
+// The result is positive for a win, negative for a loss, and 0 for a draw.
+// A value of 100 or more in absolute value means the result is a cursed win
+// or a blessed loss, depending on the 50-move counter.
+// The board is modified during the probe, but restored before returning.
+func (tb *Tablebase) ProbeDTZ(b *dragontoothmg.Board) (int, error) {
+	if err := tb.checkPosition(b); err != nil {
+		return 0, err
+	}
+	return tb.probeDTZ(b)
+}
+
+func (tb *Tablebase) probeDTZ(b *dragontoothmg.Board) (int, error) {
+	wdl, state, err := tb.search(b, true)
+	if err != nil || wdl == Draw { // DTZ tables don't store draws
+		return 0, err
+	}
+	if state == probeZeroingBestMove {
+		return dtzBeforeZeroing(wdl), nil
+	}
+	dtz, state, err := tb.probeTable(b, dtzTable, wdl)
+	if err != nil {
+		return 0, err
+	}
+	if state != probeChangeStm {
+		if wdl == CursedWin || wdl == BlessedLoss {
+			dtz += 100
+		}
+		return dtz * sign(int(wdl)), nil
+	}
+
+	// The table stores the other side to move: do a 1-ply search and find
+	// the move that minimizes the DTZ among the moves keeping the result.
+	minDTZ := 0xFFFF
+	for _, m := range b.GenerateLegalMoves() {
+		zeroing := isZeroing(b, m)
+		b.Make(m)
+		if zeroing {
+			// The DTZ of a zeroing move is the one before playing it;
+			// the search only tells the result after it.
+			var childWDL WDL
+			childWDL, _, err = tb.search(b, false)
+			dtz = -dtzBeforeZeroing(childWDL)
+		} else {
+			dtz, err = tb.probeDTZ(b)
+			dtz = -dtz
+		}
+		// A mating move has a DTZ of 1
+		if dtz == 1 && b.OurKingInCheck() && len(b.GenerateLegalMoves()) == 0 {
+			minDTZ = 1
+		}
+		if !zeroing {
+			dtz += sign(dtz)
+		}
+		if dtz < minDTZ && sign(dtz) == sign(int(wdl)) {
+			minDTZ = dtz
+		}
+		b.Undo()
+		if err != nil {
+			return 0, err
+		}
+	}
+	// Without legal moves, the position is a checkmate
+	if minDTZ == 0xFFFF {
+		return -1, nil
+	}
+	return minDTZ, nil
+}
+
+// A root move ranked by the tablebase
+type RootMove struct {
+	Move dragontoothmg.Move
+	// DTZ of the position after the move, from the point of view of the side
+	// to move at the root, counted from the root position
+	DTZ int
+	// Higher is better. Moves with the same rank have the same outcome under
+	// the 50-move rule; among winning moves, a shorter DTZ ranks higher.
+	Rank int
+}
+
+const maxDTZ = 1 << 18
+
+// Ranks the legal moves of the position using the DTZ tables, taking the
+// current halfmove clock into account: a win that can't be converted before
+// the 50-move rule applies is ranked below wins that can. The moves are
+// returned in decreasing order of rank.
+func (tb *Tablebase) RankRootMoves(b *dragontoothmg.Board) ([]RootMove, error) {
+	if err := tb.checkPosition(b); err != nil {
+		return nil, err
+	}
+	cnt50 := int(b.Halfmoveclock)
+	// Repetitions make the 50-move counter untrustworthy
+	rep := b.Clone().IsRepetition(2)
+
+	moves := b.GenerateLegalMoves()
+	rootMoves := make([]RootMove, 0, len(moves))
+	for _, m := range moves {
+		var dtz int
+		var err error
+		b.Make(m)
+		if b.Halfmoveclock == 0 {
+			// A zeroing move: the DTZ is one of -101/-1/0/1/101
+			var wdl WDL
+			wdl, _, err = tb.search(b, false)
+			dtz = dtzBeforeZeroing(-wdl)
+		} else if b.Halfmoveclock >= 100 || b.Clone().IsRepetition(3) {
+			dtz = 0
+		} else {
+			// Take the DTZ of the new position, corrected by 1 ply
+			dtz, err = tb.probeDTZ(b)
+			dtz = -dtz
+			dtz += sign(dtz)
+		}
+		// A mating move has a DTZ of 1
+		if dtz == 2 && b.OurKingInCheck() && len(b.GenerateLegalMoves()) == 0 {
+			dtz = 1
+		}
+		b.Undo()
+		if err != nil {
+			return nil, err
+		}
+
+		// Wins are ranked equally unless the 50-move rule is in sight, and
+		// so are losses; then the shortest (longest) DTZ is preferred.
+		rank := 0
+		if dtz > 0 {
+			if dtz+cnt50 <= 99 && !rep {
+				rank = maxDTZ - dtz
+			} else {
+				rank = maxDTZ/2 - (dtz + cnt50)
+			}
+		} else if dtz < 0 {
+			if -dtz*2+cnt50 < 100 {
+				rank = -maxDTZ - dtz
+			} else {
+				rank = -maxDTZ/2 + (-dtz + cnt50)
+			}
+		}
+		rootMoves = append(rootMoves, RootMove{Move: m, DTZ: dtz, Rank: rank})
+	}
+	sort.SliceStable(rootMoves, func(i, j int) bool {
+		return rootMoves[i].Rank > rootMoves[j].Rank
+	})
+	return rootMoves, nil
+}
+
+// Returns the legal moves that preserve the best achievable result under
+// the 50-move rule, the fastest conversion first. A search can be restricted
+// to these moves to play the endgame perfectly.
+func (tb *Tablebase) FilterRootMoves(b *dragontoothmg.Board) ([]dragontoothmg.Move, error) {
+	rootMoves, err := tb.RankRootMoves(b)
+	if err != nil || len(rootMoves) == 0 {
+		return nil, err
+	}
+	// Keep the moves with the same outcome as the best one
+	outcome := func(rank int) int {
+		if rank >= maxDTZ/2 {
+			return 2
+		} else if rank > 0 {
+			return 1
+		} else if rank == 0 {
+			return 0
+		} else if rank > -maxDTZ/2 {
+			return -1
+		}
+		return -2
+	}
+	best := outcome(rootMoves[0].Rank)
+	moves := make([]dragontoothmg.Move, 0, len(rootMoves))
+	for _, rm := range rootMoves {
+		if outcome(rm.Rank) != best {
+			break
+		}
+		moves = append(moves, rm.Move)
+	}
+	return moves, nil
+}
@@ -0,0 +1,304 @@
+package syzygy
+
+import (
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IlikeChooros/dragontoothmg"
+)
+
+func openTestTablebase(t *testing.T) *Tablebase {
+	tb, err := Open("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tb.MaxPieces != 3 {
+		t.Fatal("Expected 3 piece tables, got", tb.MaxPieces)
+	}
+	return tb
+}
+
+func TestProbeWDL(t *testing.T) {
+	tb := openTestTablebase(t)
+	tests := []struct {
+		fen string
+		wdl WDL
+	}{
+		{"7k/8/8/8/8/8/8/KQ6 w - - 0 1", Win},
+		{"7k/8/8/8/8/8/8/KQ6 b - - 0 1", Loss},
+		{"8/8/8/8/8/2k5/1q6/K7 w - - 0 1", Loss}, // checkmated
+		{"k7/2Q5/1K6/8/8/8/8/8 b - - 0 1", Draw}, // stalemate
+		{"8/8/8/8/8/8/kQ6/7K b - - 0 1", Draw},   // the queen hangs
+		{"7k/8/8/8/8/8/7P/7K w - - 0 1", Draw},   // rook pawn
+		{"4k3/8/4K3/4P3/8/8/8/8 w - - 0 1", Win}, // king on the sixth rank
+		{"4k3/8/4K3/4P3/8/8/8/8 b - - 0 1", Loss},
+		{"7k/8/8/8/8/8/8/KR6 w - - 0 1", Win},
+		{"8/8/8/8/8/8/8/KB5k w - - 0 1", Draw},
+		{"8/8/8/8/8/8/8/kn5K b - - 0 1", Draw},
+		{"8/8/8/8/8/8/8/K6k w - - 0 1", Draw},
+	}
+	for _, test := range tests {
+		b := dragontoothmg.ParseFen(test.fen)
+		wdl, err := tb.ProbeWDL(&b)
+		if err != nil {
+			t.Error(test.fen, err)
+		} else if wdl != test.wdl {
+			t.Error("Wrong WDL in", test.fen, "expected", test.wdl, "but got", wdl)
+		}
+	}
+}
+
+func TestProbeErrors(t *testing.T) {
+	tb := openTestTablebase(t)
+	tests := []struct {
+		fen string
+		err error
+	}{
+		{"8/8/8/8/8/8/8/KQ2k2r w - - 0 1", ErrTooManyPieces},
+		{"4k3/8/8/8/8/8/8/4K2R w K - 0 1", ErrCastlingRights},
+		{"8/8/8/8/8/8/8/K3k2r w - - 0 1", nil},
+		{"8/8/8/8/8/8/8/K3k2q w - - 0 1", nil},
+	}
+	for _, test := range tests {
+		b := dragontoothmg.ParseFen(test.fen)
+		_, err := tb.ProbeWDL(&b)
+		if !errors.Is(err, test.err) {
+			t.Error("Probing", test.fen, "expected error", test.err, "but got", err)
+		}
+	}
+	dir := t.TempDir()
+	data, err := os.ReadFile(filepath.Join("testdata", "KRvK.rtbw"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "KRvK.rtbw"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	partial, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := dragontoothmg.ParseFen("7k/8/8/8/8/8/8/KQ6 w - - 0 1")
+	if _, err := partial.ProbeWDL(&b); !errors.Is(err, ErrMissingTable) {
+		t.Error("Expected a missing table error, got", err)
+	}
+}
+
+func TestProbeDTZ(t *testing.T) {
+	tb := openTestTablebase(t)
+	tests := []struct {
+		fen string
+		dtz int
+	}{
+		{"k7/8/1K6/8/8/8/7Q/8 w - - 0 1", 1},   // mate in one
+		{"8/8/8/8/8/2k5/1q6/K7 w - - 0 1", -1}, // checkmated
+		{"k7/2Q5/1K6/8/8/8/8/8 b - - 0 1", 0},  // stalemate
+		{"8/8/8/8/8/8/4P3/k3K3 w - - 0 1", 1},  // the pawn push zeroes
+		{"8/8/8/8/8/8/kQ6/7K b - - 0 1", 0},
+	}
+	for _, test := range tests {
+		b := dragontoothmg.ParseFen(test.fen)
+		dtz, err := tb.ProbeDTZ(&b)
+		if err != nil {
+			t.Error(test.fen, err)
+		} else if dtz != test.dtz {
+			t.Error("Wrong DTZ in", test.fen, "expected", test.dtz, "but got", dtz)
+		}
+	}
+}
+
+// Checks the probed values against a one ply search over the children,
+// for a sample of the positions in the test tables.
+func TestProbeConsistency(t *testing.T) {
+	tb := openTestTablebase(t)
+	r := rand.New(rand.NewSource(1))
+	for _, signature := range []string{"KQvK", "KRvK", "KPvK"} {
+		m := &solvedMaterial{signature: signature, pieces: signaturePieces(signature)}
+		checked := 0
+		for checked < 300 {
+			b := m.board(r.Intn(2 * 64 * 64 * 64))
+			if b == nil {
+				continue
+			}
+			checked++
+			checkConsistency(t, tb, b)
+		}
+	}
+}
+
+func checkConsistency(t *testing.T, tb *Tablebase, b *dragontoothmg.Board) {
+	fen := b.ToFen()
+	wdl, err := tb.ProbeWDL(b)
+	if err != nil {
+		t.Fatal(fen, err)
+	}
+	dtz, err := tb.ProbeDTZ(b)
+	if err != nil {
+		t.Fatal(fen, err)
+	}
+	moves := b.GenerateLegalMoves()
+	bestWDL := Loss
+	if len(moves) == 0 && !b.OurKingInCheck() {
+		bestWDL = Draw
+	}
+	bestDTZ := 0
+	if len(moves) == 0 && b.OurKingInCheck() {
+		bestDTZ = -1
+	}
+	for _, mv := range moves {
+		zeroing := isZeroing(b, mv)
+		b.Make(mv)
+		childWDL, err := tb.ProbeWDL(b)
+		if err != nil {
+			t.Fatal(b.ToFen(), err)
+		}
+		childDTZ, err := tb.ProbeDTZ(b)
+		if err != nil {
+			t.Fatal(b.ToFen(), err)
+		}
+		mate := b.OurKingInCheck() && len(b.GenerateLegalMoves()) == 0
+		b.Undo()
+		if -childWDL > bestWDL {
+			bestWDL = -childWDL
+		}
+		var d int
+		if zeroing {
+			d = -dtzBeforeZeroing(childWDL)
+		} else if mate {
+			d = 1
+		} else {
+			d = -childDTZ - sign(childDTZ)
+		}
+		if sign(d) == sign(int(wdl)) && (bestDTZ == 0 || d < bestDTZ || sign(bestDTZ) != sign(d)) {
+			bestDTZ = d
+		}
+	}
+	if wdl != bestWDL {
+		t.Error("Inconsistent WDL in", fen, "probed", wdl, "but the search gives", bestWDL)
+	}
+	if dtz != bestDTZ {
+		t.Error("Inconsistent DTZ in", fen, "probed", dtz, "but the search gives", bestDTZ)
+	}
+}
+
+// The test tables are written by our own encoder, so the reader is also checked
+// against the official tables, when SYZYGY_PATH names a directory holding them
+// (KQvK, KRvK and KPvK, from https://tablebase.lichess.ovh/tables/standard/3-4-5/).
+func TestOfficialTables(t *testing.T) {
+	dir := os.Getenv("SYZYGY_PATH")
+	if dir == "" {
+		t.Skip("set SYZYGY_PATH to a directory of the official Syzygy tables")
+	}
+	tb, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		fen string
+		wdl WDL
+		dtz int
+	}{
+		{"6k1/8/6K1/8/8/8/8/R7 w - - 0 1", Win, 1},   // Ra8 mates
+		{"7k/8/6K1/8/8/8/8/R7 b - - 0 1", Loss, -2},  // Kg8 and Ra8 mates
+		{"8/8/8/8/8/8/kR6/7K b - - 0 1", Draw, 0},    // the rook hangs
+		{"k7/8/1K6/8/8/8/7Q/8 w - - 0 1", Win, 1},    // Qh8 mates
+		{"k7/2Q5/1K6/8/8/8/8/8 b - - 0 1", Draw, 0},  // stalemate
+		{"8/8/8/8/8/8/4P3/k3K3 w - - 0 1", Win, 1},   // the pawn push zeroes
+		{"4k3/4P3/4K3/8/8/8/8/8 b - - 0 1", Draw, 0}, // stalemate
+		{"7k/8/8/8/8/8/7P/7K w - - 0 1", Draw, 0},    // rook pawn
+	}
+	for _, test := range tests {
+		b := dragontoothmg.ParseFen(test.fen)
+		wdl, err := tb.ProbeWDL(&b)
+		if err != nil {
+			t.Fatal(test.fen, err)
+		}
+		dtz, err := tb.ProbeDTZ(&b)
+		if err != nil {
+			t.Fatal(test.fen, err)
+		}
+		if wdl != test.wdl || dtz != test.dtz {
+			t.Error("Wrong values in", test.fen, "expected", test.wdl, test.dtz, "but got", wdl, dtz)
+		}
+	}
+
+	// Both sets of tables hold the exact values, so they must agree everywhere
+	testTb := openTestTablebase(t)
+	r := rand.New(rand.NewSource(1))
+	for _, signature := range []string{"KQvK", "KRvK", "KPvK"} {
+		m := &solvedMaterial{signature: signature, pieces: signaturePieces(signature)}
+		checked := 0
+		for checked < 1000 {
+			b := m.board(r.Intn(2 * 64 * 64 * 64))
+			if b == nil {
+				continue
+			}
+			checked++
+			wdl, err := tb.ProbeWDL(b)
+			if err != nil {
+				t.Fatal(b.ToFen(), err)
+			}
+			dtz, err := tb.ProbeDTZ(b)
+			if err != nil {
+				t.Fatal(b.ToFen(), err)
+			}
+			testWDL, _ := testTb.ProbeWDL(b)
+			testDTZ, _ := testTb.ProbeDTZ(b)
+			if wdl != testWDL || dtz != testDTZ {
+				t.Error("In", b.ToFen(), "the official tables give", wdl, dtz, "but the test tables", testWDL, testDTZ)
+			}
+			if checked%10 == 0 {
+				checkConsistency(t, tb, b)
+			}
+		}
+	}
+}
+
+func TestRankRootMoves(t *testing.T) {
+	tb := openTestTablebase(t)
+	b := dragontoothmg.ParseFen("k7/8/1K6/8/8/8/7Q/8 w - - 0 1")
+	rootMoves, err := tb.RankRootMoves(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rootMoves) != len(b.GenerateLegalMoves()) {
+		t.Error("Expected every legal move to be ranked")
+	}
+	for i := 1; i < len(rootMoves); i++ {
+		if rootMoves[i].Rank > rootMoves[i-1].Rank {
+			t.Error("Root moves are not sorted by rank")
+		}
+	}
+	if rootMoves[0].Move.String() != "h2h8" || rootMoves[0].DTZ != 1 {
+		t.Error("Expected the mate in one first, got", &rootMoves[0].Move, rootMoves[0].DTZ)
+	}
+
+	filtered, err := tb.FilterRootMoves(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, mv := range filtered {
+		b.Make(mv)
+		wdl, _ := tb.ProbeWDL(&b)
+		b.Undo()
+		if wdl != Loss {
+			t.Error("Filtered move", &mv, "does not win")
+		}
+	}
+	if len(filtered) == 0 || len(filtered) == len(rootMoves) {
+		t.Error("Expected only the winning moves, got", len(filtered), "of", len(rootMoves))
+	}
+
+	// With the 50-move rule about to draw, a slow win is as good as a draw
+	b = dragontoothmg.ParseFen("8/8/8/3k4/8/8/8/KQ6 w - - 99 80")
+	rootMoves, err = tb.RankRootMoves(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootMoves[0].Rank >= maxDTZ/2 {
+		t.Error("Expected no winning move with the 50-move rule, got", rootMoves[0])
+	}
+}
@@ -0,0 +1,520 @@
+package syzygy
+
+// Parsing and decompression of .rtbw and .rtbz files.
+//
+// Each file holds one or more tables of pairs data (one per side to move, and
+// per file of the leading pawn for tables with pawns). Values are compressed
+// with Recursive Pairing: every symbol of the canonical Huffman code expands
+// to a pair of symbols, recursively, down to the leaf symbols holding values.
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/IlikeChooros/dragontoothmg"
+)
+
+type tableKind uint8
+
+const (
+	wdlTable tableKind = iota
+	dtzTable
+)
+
+// Flags of a pairs data record
+const (
+	flagStm         = 1
+	flagMapped      = 2
+	flagWinPlies    = 4
+	flagLossPlies   = 8
+	flagWide        = 16
+	flagSingleValue = 128
+)
+
+// Flags of the file header
+const (
+	headerSplit    = 1
+	headerHasPawns = 2
+)
+
+var wdlMagic = [4]byte{0x71, 0xE8, 0x23, 0x5D}
+var dtzMagic = [4]byte{0xD7, 0x66, 0x0C, 0xA5}
+
+// Indexing information of a single table. Offsets point into the file data.
+type pairsData struct {
+	flags           uint8
+	sizeofBlock     uint64 // Block size in bytes
+	span            uint64 // About every span values there is a sparse index entry
+	numBlocks       int
+	maxSymLen       int
+	minSymLen       int // Also stores the value of single-value tables
+	lowestSym       int // lowestSym[l] is the symbol of length l with the lowest value
+	btree           int // btree[sym] stores the left and right symbols that expand sym
+	blockLength     int // Number of values (minus one) stored in each block
+	blockLengthSize int // Padded size of the block length table
+	sparseIndex     int // Block and offset of every span-th value
+	sparseIndexSize int
+	data            int      // Start of the Huffman compressed data
+	base64          []uint64 // base64[l - minSymLen] is the 64-bit padded lowest symbol of length l
+	symlen          []uint8  // Number of values (minus one) represented by a symbol
+
+	pieces   [tbPieces]uint8      // Pieces in the order that defines the groups
+	groupIdx [tbPieces + 1]uint64 // Multiplier of each group in the index
+	groupLen [tbPieces + 1]int    // Number of pieces in each group, zero terminated
+	mapIdx   [4]uint16            // Win, loss, cursed win, blessed loss (DTZ only)
+}
+
+// A single .rtbw or .rtbz file. The header information is known from the
+// file name; the file itself is read at first access.
+type table struct {
+	kind            tableKind
+	path            string
+	key, key2       string // Material signatures with the stronger side as white, and as black
+	pieceCount      int
+	hasPawns        bool
+	hasUniquePieces bool
+	pawnCount       [2]int // Pawns of the leading color, and of the other color
+
+	once   sync.Once
+	err    error
+	data   []byte
+	dtzMap int // Offset of the DTZ value maps
+	items  [2][4]pairsData
+}
+
+// Creates a table from its material signature, such as "KRPvKR".
+// The file is not read until the first probe.
+func newTable(kind tableKind, path, signature string) (*table, error) {
+	white, black, ok := strings.Cut(signature, "v")
+	if !ok || !strings.HasPrefix(white, "K") || !strings.HasPrefix(black, "K") {
+		return nil, fmt.Errorf("syzygy: invalid material signature %q", signature)
+	}
+	t := &table{kind: kind, path: path, key: signature, key2: black + "v" + white}
+	var counts [2][7]int
+	for side, pieces := range [2]string{white, black} {
+		for _, c := range pieces {
+			p := strings.IndexRune(" PNBRQK", c)
+			if p <= 0 {
+				return nil, fmt.Errorf("syzygy: invalid material signature %q", signature)
+			}
+			counts[side][p]++
+			t.pieceCount++
+		}
+	}
+	if t.pieceCount > tbPieces {
+		return nil, fmt.Errorf("syzygy: too many pieces in %q", signature)
+	}
+	for side := 0; side < 2; side++ {
+		for p := dragontoothmg.Pawn; p < dragontoothmg.King; p++ {
+			if counts[side][p] == 1 {
+				t.hasUniquePieces = true
+			}
+		}
+	}
+	whitePawns, blackPawns := counts[0][dragontoothmg.Pawn], counts[1][dragontoothmg.Pawn]
+	t.hasPawns = whitePawns+blackPawns > 0
+
+	// The leading color is the side with fewer pawns, for better compression
+	if blackPawns == 0 || (whitePawns != 0 && blackPawns >= whitePawns) {
+		t.pawnCount = [2]int{whitePawns, blackPawns}
+	} else {
+		t.pawnCount = [2]int{blackPawns, whitePawns}
+	}
+	return t, nil
+}
+
+// Returns the pairs data for the given side to move and leading pawn file.
+func (t *table) get(stm, file int) *pairsData {
+	sides := 1
+	if t.kind == wdlTable {
+		sides = 2
+	}
+	if !t.hasPawns {
+		file = 0
+	}
+	return &t.items[stm%sides][file]
+}
+
+// Whether a DTZ table stores positions with the given side to move.
+func (t *table) storesSide(stm, file int) bool {
+	flags := t.get(stm, file).flags
+	return int(flags&flagStm) == stm || (t.key == t.key2 && !t.hasPawns)
+}
+
+// Reads and parses the file, once.
+func (t *table) load() error {
+	t.once.Do(func() {
+		data, err := os.ReadFile(t.path)
+		if err != nil {
+			t.err = fmt.Errorf("syzygy: %w", err)
+			return
+		}
+		t.err = t.parse(data)
+	})
+	return t.err
+}
+
+// Little-endian and big-endian helpers that read zeroes past the end of
+// the data, so that corrupt files cannot cause out-of-range panics.
+func le16(data []byte, off int) int {
+	if off < 0 || off+2 > len(data) {
+		return 0
+	}
+	return int(binary.LittleEndian.Uint16(data[off:]))
+}
+
+func le32(data []byte, off int) int {
+	if off < 0 || off+4 > len(data) {
+		return 0
+	}
+	return int(binary.LittleEndian.Uint32(data[off:]))
+}
+
+func be32(data []byte, off int) uint64 {
+	if off < 0 || off+4 > len(data) {
+		return 0
+	}
+	return uint64(binary.BigEndian.Uint32(data[off:]))
+}
+
+// Populates the pairs data records from the file contents.
+func (t *table) parse(data []byte) (err error) {
+	// A malformed file may still index out of the data; report it as an error.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("syzygy: corrupt file %s", t.path)
+		}
+	}()
+
+	magic := wdlMagic
+	if t.kind == dtzTable {
+		magic = dtzMagic
+	}
+	if len(data) < 5 || [4]byte(data[:4]) != magic {
+		return fmt.Errorf("syzygy: invalid magic number in %s", t.path)
+	}
+	if (data[4]&headerHasPawns != 0) != t.hasPawns || (data[4]&headerSplit != 0) != (t.key != t.key2) {
+		return fmt.Errorf("syzygy: header of %s does not match its name", t.path)
+	}
+	pos := 5
+
+	sides := 1
+	if t.kind == wdlTable && t.key != t.key2 {
+		sides = 2
+	}
+	maxFile := 0
+	if t.hasPawns {
+		maxFile = 3
+	}
+	pp := t.hasPawns && t.pawnCount[1] != 0 // Pawns on both sides
+
+	for f := 0; f <= maxFile; f++ {
+		order := [2][2]int{{int(data[pos] & 0xF), 0xF}, {int(data[pos] >> 4), 0xF}}
+		if pp {
+			order[0][1] = int(data[pos+1] & 0xF)
+			order[1][1] = int(data[pos+1] >> 4)
+			pos++
+		}
+		pos++
+		for k := 0; k < t.pieceCount; k, pos = k+1, pos+1 {
+			for i := 0; i < sides; i++ {
+				if i == 0 {
+					t.get(i, f).pieces[k] = data[pos] & 0xF
+				} else {
+					t.get(i, f).pieces[k] = data[pos] >> 4
+				}
+			}
+		}
+		for i := 0; i < sides; i++ {
+			t.setGroups(t.get(i, f), order[i], f)
+		}
+	}
+	pos += pos & 1 // Word alignment
+
+	for f := 0; f <= maxFile; f++ {
+		for i := 0; i < sides; i++ {
+			pos = t.get(i, f).setSizes(data, pos)
+		}
+	}
+
+	if t.kind == dtzTable {
+		pos = t.setDtzMap(data, pos, maxFile)
+	}
+
+	for f := 0; f <= maxFile; f++ {
+		for i := 0; i < sides; i++ {
+			d := t.get(i, f)
+			d.sparseIndex = pos
+			pos += d.sparseIndexSize * 6
+		}
+	}
+	for f := 0; f <= maxFile; f++ {
+		for i := 0; i < sides; i++ {
+			d := t.get(i, f)
+			d.blockLength = pos
+			pos += d.blockLengthSize * 2
+		}
+	}
+	for f := 0; f <= maxFile; f++ {
+		for i := 0; i < sides; i++ {
+			d := t.get(i, f)
+			pos = (pos + 0x3F) &^ 0x3F // 64 byte alignment
+			d.data = pos
+			pos += d.numBlocks * int(d.sizeofBlock)
+		}
+	}
+	if pos > len(data) {
+		return fmt.Errorf("syzygy: truncated file %s", t.path)
+	}
+	t.data = data
+	return nil
+}
+
+// Groups together the pieces that are encoded together. A group contains the
+// pieces of the same type and color, except the leading group which holds the
+// leading pawns, or three unique pieces, or the two kings.
+// For instance KRvKN -> KRK + N, KNNvK -> KK + NN, KPPvKP -> P + PP + K + K.
+//
+// The order of the groups in the index is a parameter of each table: the
+// leading group is at position order[0] and the remaining pawns at order[1].
+func (t *table) setGroups(d *pairsData, order [2]int, file int) {
+	firstLen := 2
+	if t.hasPawns {
+		firstLen = 0
+	} else if t.hasUniquePieces {
+		firstLen = 3
+	}
+	n := 0
+	d.groupLen[n] = 1
+	for i := 1; i < t.pieceCount; i++ {
+		firstLen--
+		if firstLen > 0 || d.pieces[i] == d.pieces[i-1] {
+			d.groupLen[n]++
+		} else {
+			n++
+			d.groupLen[n] = 1
+		}
+	}
+	n++
+	d.groupLen[n] = 0
+
+	pp := t.hasPawns && t.pawnCount[1] != 0
+	next := 1
+	freeSquares := 64 - d.groupLen[0]
+	if pp {
+		next = 2
+		freeSquares -= d.groupLen[1]
+	}
+	var idx uint64 = 1
+	for k := 0; next < n || k == order[0] || k == order[1]; k++ {
+		if k == order[0] { // Leading pawns or pieces
+			d.groupIdx[0] = idx
+			if t.hasPawns {
+				idx *= leadPawnsSize[d.groupLen[0]][file]
+			} else if t.hasUniquePieces {
+				idx *= 31332
+			} else {
+				idx *= 462
+			}
+		} else if k == order[1] { // Remaining pawns
+			d.groupIdx[1] = idx
+			idx *= binomial[d.groupLen[1]][48-d.groupLen[0]]
+		} else { // Remaining pieces
+			d.groupIdx[next] = idx
+			idx *= binomial[d.groupLen[next]][freeSquares]
+			freeSquares -= d.groupLen[next]
+			next++
+		}
+	}
+	d.groupIdx[n] = idx
+}
+
+// Returns the number of positions in the table.
+func (d *pairsData) size() uint64 {
+	n := 0
+	for d.groupLen[n] != 0 {
+		n++
+	}
+	return d.groupIdx[n]
+}
+
+// Reads the block and Huffman code sizes, and the symbol tree.
+func (d *pairsData) setSizes(data []byte, pos int) int {
+	d.flags = data[pos]
+	pos++
+	if d.flags&flagSingleValue != 0 {
+		d.minSymLen = int(data[pos]) // the single value
+		return pos + 1
+	}
+	d.sizeofBlock = 1 << data[pos]
+	d.span = 1 << data[pos+1]
+	d.sparseIndexSize = int((d.size() + d.span - 1) / d.span)
+	padding := int(data[pos+2])
+	d.numBlocks = le32(data, pos+3)
+	d.blockLengthSize = d.numBlocks + padding
+	d.maxSymLen = int(data[pos+7])
+	d.minSymLen = int(data[pos+8])
+	pos += 9
+	d.lowestSym = pos
+
+	// Symbols of the canonical Huffman code are ordered so that longer codes
+	// have lower values. base64 holds, for each code length, the lowest code
+	// of that length left-aligned in 64 bits, so that the length of the code
+	// at the beginning of a 64-bit buffer is found by comparing against it.
+	d.base64 = make([]uint64, d.maxSymLen-d.minSymLen+1)
+	for i := len(d.base64) - 2; i >= 0; i-- {
+		d.base64[i] = uint64(int(d.base64[i+1])+le16(data, d.lowestSym+2*i)-le16(data, d.lowestSym+2*(i+1))) / 2
+	}
+	for i := range d.base64 {
+		d.base64[i] <<= 64 - i - d.minSymLen
+	}
+	pos += len(d.base64) * 2
+
+	d.symlen = make([]uint8, le16(data, pos))
+	pos += 2
+	d.btree = pos
+	visited := make([]bool, len(d.symlen))
+	for sym := range d.symlen {
+		if !visited[sym] {
+			d.symlen[sym] = d.setSymlen(data, sym, visited)
+		}
+	}
+	return pos + len(d.symlen)*3 + len(d.symlen)&1
+}
+
+// Returns the left (first 12 bits) or the right (last 12 bits) child symbol.
+// Leaf symbols store their value as the left child.
+func (d *pairsData) left(data []byte, sym int) int {
+	off := d.btree + 3*sym
+	return int(data[off+1]&0xF)<<8 | int(data[off])
+}
+
+func (d *pairsData) right(data []byte, sym int) int {
+	off := d.btree + 3*sym
+	return int(data[off+2])<<4 | int(data[off+1]>>4)
+}
+
+// Computes the number of values (minus one) a symbol expands to.
+func (d *pairsData) setSymlen(data []byte, sym int, visited []bool) uint8 {
+	visited[sym] = true
+	right := d.right(data, sym)
+	if right == 0xFFF {
+		return 0
+	}
+	left := d.left(data, sym)
+	if !visited[left] {
+		d.symlen[left] = d.setSymlen(data, left, visited)
+	}
+	if !visited[right] {
+		d.symlen[right] = d.setSymlen(data, right, visited)
+	}
+	return d.symlen[left] + d.symlen[right] + 1
+}
+
+// Reads the maps from stored values to DTZ values.
+func (t *table) setDtzMap(data []byte, pos int, maxFile int) int {
+	t.dtzMap = pos
+	for f := 0; f <= maxFile; f++ {
+		d := t.get(0, f)
+		if d.flags&flagMapped == 0 {
+			continue
+		}
+		if d.flags&flagWide != 0 {
+			pos += pos & 1 // Word alignment
+			for i := 0; i < 4; i++ {
+				d.mapIdx[i] = uint16((pos-t.dtzMap)/2 + 1)
+				pos += 2*le16(data, pos) + 2
+			}
+		} else {
+			for i := 0; i < 4; i++ {
+				d.mapIdx[i] = uint16(pos - t.dtzMap + 1)
+				pos += int(data[pos]) + 1
+			}
+		}
+	}
+	return pos + pos&1
+}
+
+// Returns the value stored at the given index.
+func (d *pairsData) decompress(data []byte, idx uint64) int {
+	if d.flags&flagSingleValue != 0 {
+		return d.minSymLen
+	}
+
+	// Find the block holding the value. Sparse index entry k stores the block
+	// and the offset within the block of the value at index k*span + span/2.
+	k := int(idx / d.span)
+	block := le32(data, d.sparseIndex+6*k)
+	offset := le16(data, d.sparseIndex+6*k+4)
+	offset += int(idx%d.span) - int(d.span/2)
+	for offset < 0 {
+		block--
+		offset += le16(data, d.blockLength+2*block) + 1
+	}
+	for offset > le16(data, d.blockLength+2*block) {
+		offset -= le16(data, d.blockLength+2*block) + 1
+		block++
+	}
+
+	// Decode the Huffman symbols of the block until reaching the one which
+	// expands to the value at our offset.
+	ptr := d.data + block*int(d.sizeofBlock)
+	buf64 := be32(data, ptr)<<32 | be32(data, ptr+4)
+	ptr += 8
+	buf64Size := 64
+	var sym int
+	for {
+		length := 0 // code length minus minSymLen
+		for buf64 < d.base64[length] {
+			length++
+		}
+		sym = int((buf64-d.base64[length])>>(64-length-d.minSymLen)) + le16(data, d.lowestSym+2*length)
+		if offset < int(d.symlen[sym])+1 {
+			break
+		}
+		offset -= int(d.symlen[sym]) + 1
+		length += d.minSymLen
+		buf64 <<= length
+		buf64Size -= length
+		if buf64Size <= 32 { // refill the buffer
+			buf64Size += 32
+			buf64 |= be32(data, ptr) << (64 - buf64Size)
+			ptr += 4
+		}
+	}
+
+	// Expand the symbol, following the side of the pair holding our offset
+	for d.symlen[sym] != 0 {
+		left := d.left(data, sym)
+		if offset < int(d.symlen[left])+1 {
+			sym = left
+		} else {
+			offset -= int(d.symlen[left]) + 1
+			sym = d.right(data, sym)
+		}
+	}
+	return d.left(data, sym)
+}
+
+// Converts a stored DTZ value into plies, given the WDL value of the position.
+func (t *table) mapDtz(file int, value int, wdl WDL) int {
+	d := t.get(0, file)
+	if d.flags&flagMapped != 0 {
+		// Index of the map for win, loss, cursed win and blessed loss
+		m := int(d.mapIdx[[...]int{1, 3, 0, 2, 0}[wdl+2]])
+		if d.flags&flagWide != 0 {
+			value = le16(t.data, t.dtzMap+2*(m+value))
+		} else {
+			value = int(t.data[t.dtzMap+m+value])
+		}
+	}
+	// Values are stored in moves or in plies; convert to plies
+	if (wdl == Win && d.flags&flagWinPlies == 0) || (wdl == Loss && d.flags&flagLossPlies == 0) ||
+		wdl == CursedWin || wdl == BlessedLoss {
+		value *= 2
+	}
+	return value + 1
+}
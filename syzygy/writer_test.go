@@ -0,0 +1,800 @@
+package syzygy
+
+// Writes the small tables in testdata. The positions are solved by brute
+// force with the dragontoothmg move generator, and the values are stored in
+// the Syzygy format with a simple encoder: a few rounds of Recursive Pairing
+// followed by a canonical Huffman code.
+//
+// Regenerate the files with: go test ./syzygy -run TestWriteTables -update
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"flag"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/IlikeChooros/dragontoothmg"
+)
+
+var update = flag.Bool("update", false, "regenerate the tables in testdata")
+
+var testTables = []string{"KQvK", "KRvK", "KBvK", "KNvK", "KPvK"}
+
+func TestWriteTables(t *testing.T) {
+	if !*update {
+		t.Skip("run with -update to regenerate the tables")
+	}
+	s := newSolver()
+	for _, sig := range testTables {
+		m := s.solve(sig)
+		for _, kind := range []tableKind{wdlTable, dtzTable} {
+			ext := ".rtbw"
+			if kind == dtzTable {
+				ext = ".rtbz"
+			}
+			data := encodeTable(m, kind)
+			if err := os.WriteFile(filepath.Join("testdata", sig+ext), data, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+// ------
+// SOLVER
+// ------
+
+// All placements of the pieces of one material signature, solved.
+// Positions are indexed by side to move and the square of each piece.
+type solvedMaterial struct {
+	signature string
+	pieces    []uint8 // Syzygy piece codes, in signature order
+	legal     []bool
+	wdl       []WDL
+	dtz       []int
+}
+
+type solver struct {
+	materials map[string]*solvedMaterial
+}
+
+func newSolver() *solver {
+	return &solver{materials: map[string]*solvedMaterial{}}
+}
+
+// Piece codes of a signature, white pieces first.
+func signaturePieces(signature string) []uint8 {
+	var pieces []uint8
+	white, black, _ := strings.Cut(signature, "v")
+	for side, s := range []string{white, black} {
+		for _, c := range s {
+			p := uint8(strings.IndexRune(" PNBRQK", c))
+			if side == 1 {
+				p |= 8
+			}
+			pieces = append(pieces, p)
+		}
+	}
+	return pieces
+}
+
+// Builds a FEN string out of pieces and squares.
+func placementFen(pieces []uint8, squares []int, wtm bool) string {
+	var board [64]byte
+	for i, p := range pieces {
+		c := " PNBRQK"[p&7]
+		if p&8 != 0 {
+			c += 'a' - 'A'
+		}
+		board[squares[i]] = c
+	}
+	var fen strings.Builder
+	for r := 7; r >= 0; r-- {
+		empty := 0
+		for f := 0; f < 8; f++ {
+			if board[r*8+f] == 0 {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				fen.WriteByte(byte('0' + empty))
+				empty = 0
+			}
+			fen.WriteByte(board[r*8+f])
+		}
+		if empty > 0 {
+			fen.WriteByte(byte('0' + empty))
+		}
+		if r > 0 {
+			fen.WriteByte('/')
+		}
+	}
+	if wtm {
+		fen.WriteString(" w - - 0 1")
+	} else {
+		fen.WriteString(" b - - 0 1")
+	}
+	return fen.String()
+}
+
+// Raw index of a board of the given material. Identical pieces are
+// assigned squares in increasing order.
+func rawIndex(m *solvedMaterial, b *dragontoothmg.Board) int {
+	idx := 0
+	if !b.Wtomove {
+		idx = 1
+	}
+	used := uint64(0)
+	for _, p := range m.pieces {
+		bb := &b.White
+		if p&8 != 0 {
+			bb = &b.Black
+		}
+		var pieceBB uint64
+		switch p & 7 {
+		case dragontoothmg.Pawn:
+			pieceBB = bb.Pawns
+		case dragontoothmg.Knight:
+			pieceBB = bb.Knights
+		case dragontoothmg.Bishop:
+			pieceBB = bb.Bishops
+		case dragontoothmg.Rook:
+			pieceBB = bb.Rooks
+		case dragontoothmg.Queen:
+			pieceBB = bb.Queens
+		case dragontoothmg.King:
+			pieceBB = bb.Kings
+		}
+		sq := bits.TrailingZeros64(pieceBB &^ used)
+		used |= uint64(1) << sq
+		idx = idx*64 + sq
+	}
+	return idx
+}
+
+// Decodes a raw index into squares and side to move.
+func rawSquares(m *solvedMaterial, idx int) ([]int, bool) {
+	squares := make([]int, len(m.pieces))
+	for i := len(m.pieces) - 1; i >= 0; i-- {
+		squares[i] = idx % 64
+		idx /= 64
+	}
+	return squares, idx == 0
+}
+
+// Returns the board of a raw index, or nil if the placement is not legal.
+func (m *solvedMaterial) board(idx int) *dragontoothmg.Board {
+	squares, wtm := rawSquares(m, idx)
+	occupied := uint64(0)
+	for i, sq := range squares {
+		if occupied&(uint64(1)<<sq) != 0 {
+			return nil
+		}
+		occupied |= uint64(1) << sq
+		if m.pieces[i]&7 == dragontoothmg.Pawn && (sq < 8 || sq >= 56) {
+			return nil
+		}
+	}
+	b, ok := dragontoothmg.FromFen(placementFen(m.pieces, squares, wtm))
+	if !ok {
+		return nil
+	}
+	// The side that just moved can't be in check
+	oppKing := b.Black.Kings
+	if !wtm {
+		oppKing = b.White.Kings
+	}
+	if b.UnderDirectAttack(!wtm, uint8(bits.TrailingZeros64(oppKing))) {
+		return nil
+	}
+	return b
+}
+
+// A move of a solved position
+type edge struct {
+	child   int  // raw index of the child, -1 if it has different material
+	zeroing bool // capture or pawn move
+	mate    bool // the move checkmates
+	value   WDL  // value of the child for its side to move, if known upfront
+}
+
+func (s *solver) solve(signature string) *solvedMaterial {
+	if m, ok := s.materials[signature]; ok {
+		return m
+	}
+	pieces := signaturePieces(signature)
+	size := 2
+	for range pieces {
+		size *= 64
+	}
+	m := &solvedMaterial{signature: signature, pieces: pieces,
+		legal: make([]bool, size), wdl: make([]WDL, size), dtz: make([]int, size)}
+	s.materials[signature] = m
+
+	// Generate the moves of every legal position
+	edges := make([][]edge, size)
+	for idx := 0; idx < size; idx++ {
+		b := m.board(idx)
+		if b == nil {
+			continue
+		}
+		m.legal[idx] = true
+		moves := b.GenerateLegalMoves()
+		edges[idx] = make([]edge, 0, len(moves))
+		for _, mv := range moves {
+			e := edge{child: -1, zeroing: isZeroing(b, mv)}
+			b.Make(mv)
+			e.mate = b.OurKingInCheck() && len(b.GenerateLegalMoves()) == 0
+			childSignature := b.MaterialSignature()
+			if childSignature == signature {
+				e.child = rawIndex(m, b)
+			} else if bits.OnesCount64(b.White.All|b.Black.All) == 2 {
+				e.value = Draw
+			} else {
+				other, flipped := s.solveAny(childSignature)
+				e.value = other.wdl[rawIndex(other, mirrorIfFlipped(b, flipped))]
+			}
+			b.Undo()
+			edges[idx] = append(edges[idx], e)
+		}
+	}
+
+	// Win/draw/loss values, by repeated passes until nothing changes
+	const unknown = WDL(-128)
+	for idx := range m.wdl {
+		m.wdl[idx] = unknown
+		if m.legal[idx] && len(edges[idx]) == 0 {
+			b := m.board(idx)
+			if b.OurKingInCheck() {
+				m.wdl[idx] = Loss
+			} else {
+				m.wdl[idx] = Draw
+			}
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for idx := range m.wdl {
+			if !m.legal[idx] || m.wdl[idx] != unknown {
+				continue
+			}
+			allWins := true
+			for _, e := range edges[idx] {
+				value := e.value
+				if e.child >= 0 {
+					value = m.wdl[e.child]
+				}
+				if value == Loss {
+					m.wdl[idx] = Win
+					changed = true
+					break
+				}
+				if value != Win {
+					allWins = false
+				}
+			}
+			if m.wdl[idx] == unknown && allWins {
+				m.wdl[idx] = Loss
+				changed = true
+			}
+		}
+	}
+	for idx := range m.wdl {
+		if m.wdl[idx] == unknown {
+			m.wdl[idx] = Draw
+		}
+	}
+
+	// Distances to zeroing, in plies, with the same conventions as ProbeDTZ:
+	// a checkmated position has a DTZ of -1 and a mate in one a DTZ of 1.
+	const infinity = 1 << 20
+	for idx := range m.dtz {
+		m.dtz[idx] = 0
+		if m.legal[idx] && m.wdl[idx] == Win {
+			m.dtz[idx] = infinity
+		} else if m.legal[idx] && m.wdl[idx] == Loss {
+			m.dtz[idx] = -infinity
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for idx := range m.dtz {
+			if !m.legal[idx] || m.wdl[idx] == Draw {
+				continue
+			}
+			best := infinity
+			if m.wdl[idx] == Loss {
+				best = -1 // checkmated, or every move zeroing
+			}
+			for _, e := range edges[idx] {
+				var dtz int
+				if e.zeroing {
+					dtz = -dtzBeforeZeroing(e.value)
+					if e.child >= 0 {
+						dtz = -dtzBeforeZeroing(m.wdl[e.child])
+					}
+				} else if m.wdl[e.child] == Draw {
+					continue
+				} else if e.mate {
+					dtz = 1
+				} else {
+					childDtz := m.dtz[e.child]
+					if childDtz == infinity || childDtz == -infinity {
+						if m.wdl[idx] == Loss {
+							best = -infinity // not known yet
+							break
+						}
+						continue
+					}
+					dtz = -childDtz - sign(childDtz)
+				}
+				if sign(dtz) == sign(int(m.wdl[idx])) && dtz < best {
+					best = dtz
+				}
+			}
+			if best != m.dtz[idx] {
+				m.dtz[idx] = best
+				changed = true
+			}
+		}
+	}
+	return m
+}
+
+// Solves a signature given with either color as the stronger side.
+// Returns whether the colors had to be flipped.
+func (s *solver) solveAny(signature string) (*solvedMaterial, bool) {
+	for _, sig := range testTables {
+		if sig == signature {
+			return s.solve(sig), false
+		}
+	}
+	white, black, _ := strings.Cut(signature, "v")
+	return s.solve(black + "v" + white), true
+}
+
+// Mirrors the board vertically and switches colors, if flipped.
+func mirrorIfFlipped(b *dragontoothmg.Board, flipped bool) *dragontoothmg.Board {
+	if !flipped {
+		return b
+	}
+	fen := b.ToFen()
+	fields := strings.Fields(fen)
+	ranks := strings.Split(fields[0], "/")
+	for i, j := 0, len(ranks)-1; i < j; i, j = i+1, j-1 {
+		ranks[i], ranks[j] = ranks[j], ranks[i]
+	}
+	swapped := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		} else if r >= 'A' && r <= 'Z' {
+			return r - 'A' + 'a'
+		}
+		return r
+	}, strings.Join(ranks, "/"))
+	stm := "w"
+	if b.Wtomove {
+		stm = "b"
+	}
+	mirrored, _ := dragontoothmg.FromFen(swapped + " " + stm + " - - 0 1")
+	return mirrored
+}
+
+// -------
+// ENCODER
+// -------
+
+// Builds the table header for a signature, with the pieces in signature order.
+func newTestTable(kind tableKind, signature string) *table {
+	t, err := newTable(kind, "", signature)
+	if err != nil {
+		panic(err)
+	}
+	pieces := signaturePieces(signature)
+	if t.hasPawns {
+		// The leading pawns come first
+		sort.SliceStable(pieces, func(i, j int) bool {
+			return pieces[i]&7 == dragontoothmg.Pawn && pieces[j]&7 != dragontoothmg.Pawn
+		})
+	}
+	sides := 1
+	if kind == wdlTable && t.key != t.key2 {
+		sides = 2
+	}
+	for f := 0; f < 4; f++ {
+		for i := 0; i < sides; i++ {
+			d := t.get(i, f)
+			copy(d.pieces[:], pieces)
+			t.setGroups(d, [2]int{0, 0xF}, f)
+			if kind == dtzTable {
+				d.flags = flagWinPlies | flagLossPlies // stores white to move
+			}
+		}
+	}
+	return t
+}
+
+// Encodes the solved positions as a .rtbw or .rtbz file.
+func encodeTable(m *solvedMaterial, kind tableKind) []byte {
+	t := newTestTable(kind, m.signature)
+	sides := 1
+	if kind == wdlTable && t.key != t.key2 {
+		sides = 2
+	}
+	maxFile := 0
+	if t.hasPawns {
+		maxFile = 3
+	}
+
+	// Compute the value at every index. Indices without a legal position
+	// are filled afterwards with the most frequent value.
+	const dontCare = -1
+	values := [2][4][]int{}
+	for f := 0; f <= maxFile; f++ {
+		for i := 0; i < sides; i++ {
+			values[i][f] = make([]int, t.get(i, f).size())
+			for j := range values[i][f] {
+				values[i][f][j] = dontCare
+			}
+		}
+	}
+	for idx, legal := range m.legal {
+		if !legal {
+			continue
+		}
+		b := m.board(idx)
+		d, file, tbIdx, changeStm := t.index(b)
+		if changeStm {
+			continue
+		}
+		side := 0
+		if d == t.get(1, file) && sides == 2 {
+			side = 1
+		}
+		value := int(m.wdl[idx]) + 2
+		if kind == dtzTable {
+			if m.wdl[idx] == Draw {
+				continue
+			}
+			value = abs(m.dtz[idx]) - 1
+			if value > 100 {
+				panic("cursed results are not supported")
+			}
+		}
+		values[side][file][tbIdx] = value
+	}
+
+	var w bytes.Buffer
+	if kind == wdlTable {
+		w.Write(wdlMagic[:])
+	} else {
+		w.Write(dtzMagic[:])
+	}
+	var header byte
+	if t.key != t.key2 {
+		header |= headerSplit
+	}
+	if t.hasPawns {
+		header |= headerHasPawns
+	}
+	w.WriteByte(header)
+	for f := 0; f <= maxFile; f++ {
+		w.WriteByte(0) // leading group first, for both sides
+		for k := 0; k < t.pieceCount; k++ {
+			w.WriteByte(t.get(0, f).pieces[k] | t.get(0, f).pieces[k]<<4)
+		}
+	}
+	align(&w, 2)
+
+	var encoded []*encodedTable
+	for f := 0; f <= maxFile; f++ {
+		for i := 0; i < sides; i++ {
+			e := compress(values[i][f], t.get(i, f).flags)
+			encoded = append(encoded, e)
+			w.Write(e.sizes)
+		}
+	}
+	if kind == dtzTable {
+		align(&w, 2)
+	}
+	for _, e := range encoded {
+		w.Write(e.sparseIndex)
+	}
+	for _, e := range encoded {
+		w.Write(e.blockLengths)
+	}
+	for _, e := range encoded {
+		align(&w, 64)
+		w.Write(e.blocks)
+	}
+	return w.Bytes()
+}
+
+func align(w *bytes.Buffer, n int) {
+	for w.Len()%n != 0 {
+		w.WriteByte(0)
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// The sections of one compressed table
+type encodedTable struct {
+	sizes, sparseIndex, blockLengths, blocks []byte
+}
+
+const (
+	testBlockSizeLog = 5 // 32 byte blocks
+	testSpanLog      = 6 // a sparse index entry every 64 values
+)
+
+// A symbol of the Recursive Pairing grammar
+type symbol struct {
+	left, right int // children, or value and -1 for leaves
+	length      int // number of values it expands to
+}
+
+func compress(values []int, flags uint8) *encodedTable {
+	// Fill the holes with the most frequent value
+	counts := map[int]int{}
+	for _, v := range values {
+		if v >= 0 {
+			counts[v]++
+		}
+	}
+	mostFrequent, best := 0, -1
+	for v, c := range counts {
+		if c > best || (c == best && v < mostFrequent) {
+			mostFrequent, best = v, c
+		}
+	}
+	for i := range values {
+		if values[i] < 0 {
+			values[i] = mostFrequent
+		}
+	}
+	if len(counts) <= 1 {
+		return &encodedTable{sizes: []byte{flags | flagSingleValue, byte(mostFrequent)}}
+	}
+
+	// Leaf symbols, one per value; a dummy leaf guarantees two symbols
+	var symbols []symbol
+	leaf := map[int]int{}
+	seq := make([]int, len(values))
+	for i, v := range values {
+		if _, ok := leaf[v]; !ok {
+			leaf[v] = len(symbols)
+			symbols = append(symbols, symbol{v, -1, 1})
+		}
+		seq[i] = leaf[v]
+	}
+
+	// Replace the most frequent pair of adjacent symbols by a new symbol, a few times
+	for round := 0; round < 40; round++ {
+		pairs := map[[2]int]int{}
+		for i := 0; i+1 < len(seq); i++ {
+			pairs[[2]int{seq[i], seq[i+1]}]++
+		}
+		var bestPair [2]int
+		bestCount := 0
+		for p, c := range pairs {
+			if c > bestCount || (c == bestCount && (p[0] < bestPair[0] || (p[0] == bestPair[0] && p[1] < bestPair[1]))) {
+				if symbols[p[0]].length+symbols[p[1]].length <= 256 {
+					bestPair, bestCount = p, c
+				}
+			}
+		}
+		if bestCount < 8 {
+			break
+		}
+		newSym := len(symbols)
+		symbols = append(symbols, symbol{bestPair[0], bestPair[1],
+			symbols[bestPair[0]].length + symbols[bestPair[1]].length})
+		out := seq[:0]
+		for i := 0; i < len(seq); i++ {
+			if i+1 < len(seq) && seq[i] == bestPair[0] && seq[i+1] == bestPair[1] {
+				out = append(out, newSym)
+				i++
+			} else {
+				out = append(out, seq[i])
+			}
+		}
+		seq = out
+	}
+
+	// Huffman code lengths of the symbols used in the sequence
+	freq := make([]int, len(symbols))
+	for _, s := range seq {
+		freq[s]++
+	}
+	used := 0
+	for _, f := range freq {
+		if f > 0 {
+			used++
+		}
+	}
+	if used < 2 {
+		symbols = append(symbols, symbol{0, -1, 1})
+		freq = append(freq, 1)
+	}
+	codeLen := huffmanLengths(freq)
+
+	// Renumber the symbols: coded symbols by decreasing code length, then the others
+	order := make([]int, len(symbols))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return codeLen[order[i]] > codeLen[order[j]]
+	})
+	newID := make([]int, len(symbols))
+	for id, s := range order {
+		newID[s] = id
+	}
+	minLen, maxLen := 64, 0
+	for _, l := range codeLen {
+		if l > 0 {
+			minLen, maxLen = min(minLen, l), max(maxLen, l)
+		}
+	}
+	lowestSym := make([]int, maxLen+1)
+	countLen := make([]int, maxLen+2)
+	for _, l := range codeLen {
+		countLen[l]++
+	}
+	next := 0
+	for l := maxLen; l >= minLen; l-- {
+		lowestSym[l] = next
+		next += countLen[l]
+	}
+	base := make([]uint64, maxLen+2)
+	for l := maxLen - 1; l >= minLen; l-- {
+		if (base[l+1]+uint64(countLen[l+1]))%2 != 0 {
+			panic("incomplete Huffman code")
+		}
+		base[l] = (base[l+1] + uint64(countLen[l+1])) / 2
+	}
+	code := func(s int) (uint64, int) {
+		l := codeLen[s]
+		return base[l] + uint64(newID[s]-lowestSym[l]), l
+	}
+
+	// Split the sequence in blocks
+	blockBits := 8 << testBlockSizeLog
+	var blocks []byte
+	var blockLengths []int // number of values per block
+	for i := 0; i < len(seq); {
+		var bw bitWriter
+		n := 0
+		for ; i < len(seq); i++ {
+			c, l := code(seq[i])
+			if bw.n+l > blockBits || n+symbols[seq[i]].length > 65536 {
+				break
+			}
+			bw.write(c, l)
+			n += symbols[seq[i]].length
+		}
+		block := bw.bytes()
+		block = append(block, make([]byte, blockBits/8-len(block))...)
+		blocks = append(blocks, block...)
+		blockLengths = append(blockLengths, n)
+	}
+
+	// Sparse index: block and offset of the value at k * span + span / 2
+	span := 1 << testSpanLog
+	var sparse []byte
+	block, blockStart := 0, 0
+	for k := 0; k*span < len(values); k++ {
+		target := k*span + span/2
+		for block+1 < len(blockLengths) && blockStart+blockLengths[block] <= target {
+			blockStart += blockLengths[block]
+			block++
+		}
+		sparse = binary.LittleEndian.AppendUint32(sparse, uint32(block))
+		sparse = binary.LittleEndian.AppendUint16(sparse, uint16(target-blockStart))
+	}
+	var lengths []byte
+	for _, n := range blockLengths {
+		lengths = binary.LittleEndian.AppendUint16(lengths, uint16(n-1))
+	}
+
+	sizes := []byte{flags, testBlockSizeLog, testSpanLog, 0}
+	sizes = binary.LittleEndian.AppendUint32(sizes, uint32(len(blockLengths)))
+	sizes = append(sizes, byte(maxLen), byte(minLen))
+	for l := minLen; l <= maxLen; l++ {
+		sizes = binary.LittleEndian.AppendUint16(sizes, uint16(lowestSym[l]))
+	}
+	sizes = binary.LittleEndian.AppendUint16(sizes, uint16(len(symbols)))
+	for _, s := range order {
+		left, right := symbols[s].left, 0xFFF
+		if symbols[s].right >= 0 {
+			left, right = newID[symbols[s].left], newID[symbols[s].right]
+		}
+		sizes = append(sizes, byte(left), byte(left>>8)|byte(right<<4), byte(right>>4))
+	}
+	if len(symbols)%2 != 0 {
+		sizes = append(sizes, 0)
+	}
+	return &encodedTable{sizes: sizes, sparseIndex: sparse, blockLengths: lengths, blocks: blocks}
+}
+
+// Writes bits most significant first
+type bitWriter struct {
+	buf []byte
+	n   int
+}
+
+func (bw *bitWriter) write(code uint64, length int) {
+	for i := length - 1; i >= 0; i-- {
+		if bw.n%8 == 0 {
+			bw.buf = append(bw.buf, 0)
+		}
+		if code>>i&1 != 0 {
+			bw.buf[bw.n/8] |= 0x80 >> (bw.n % 8)
+		}
+		bw.n++
+	}
+}
+
+func (bw *bitWriter) bytes() []byte {
+	return bw.buf
+}
+
+// Huffman code lengths for the given frequencies; zero for unused symbols.
+func huffmanLengths(freq []int) []int {
+	type node struct {
+		weight, parent int
+	}
+	nodes := make([]node, 0, 2*len(freq))
+	h := &nodeHeap{}
+	for s, f := range freq {
+		nodes = append(nodes, node{f, -1})
+		if f > 0 {
+			heap.Push(h, heapItem{f, s})
+		}
+	}
+	for h.Len() > 1 {
+		a, b := heap.Pop(h).(heapItem), heap.Pop(h).(heapItem)
+		parent := len(nodes)
+		nodes = append(nodes, node{a.weight + b.weight, -1})
+		nodes[a.node].parent, nodes[b.node].parent = parent, parent
+		heap.Push(h, heapItem{a.weight + b.weight, parent})
+	}
+	lengths := make([]int, len(freq))
+	for s, f := range freq {
+		if f == 0 {
+			continue
+		}
+		for n := s; nodes[n].parent >= 0; n = nodes[n].parent {
+			lengths[s]++
+		}
+	}
+	return lengths
+}
+
+type heapItem struct{ weight, node int }
+type nodeHeap []heapItem
+
+func (h nodeHeap) Len() int { return len(h) }
+func (h nodeHeap) Less(i, j int) bool {
+	return h[i].weight < h[j].weight || (h[i].weight == h[j].weight && h[i].node < h[j].node)
+}
+func (h nodeHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *nodeHeap) Push(x interface{}) { *h = append(*h, x.(heapItem)) }
+func (h *nodeHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,118 @@
+package tablebase
+
+// Table files.
+//
+// A file starts with a header: the magic number, the format version, the
+// length of the material signature and the signature itself, and the
+// number of values as a little-endian uint32. One byte per position follows,
+// compressed with DEFLATE. Indices without a legal position store 255.
+
+import (
+	"bufio"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Extension of table files
+const FileExtension = ".dtb"
+
+var fileMagic = [4]byte{'D', 'T', 'B', 0x1A}
+
+const fileVersion = 1
+
+var errInvalidFile = errors.New("tablebase: invalid table file")
+
+// Writes the table in the file format.
+func (t *Table) WriteTo(w io.Writer) (int64, error) {
+	header := append(fileMagic[:], fileVersion, byte(len(t.m.signature)))
+	header = append(header, t.m.signature...)
+	header = binary.LittleEndian.AppendUint32(header, uint32(len(t.values)))
+	n, err := w.Write(header)
+	if err != nil {
+		return int64(n), err
+	}
+	counter := &countingWriter{w: w}
+	fw, err := flate.NewWriter(counter, flate.BestCompression)
+	if err != nil {
+		return int64(n), err
+	}
+	if _, err := fw.Write(t.values); err != nil {
+		return int64(n) + counter.n, err
+	}
+	err = fw.Close()
+	return int64(n) + counter.n, err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Reads a table written by WriteTo.
+func ReadTable(r io.Reader) (*Table, error) {
+	br := bufio.NewReader(r)
+	var header [6]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		return nil, err
+	}
+	if [4]byte(header[:4]) != fileMagic || header[4] != fileVersion {
+		return nil, errInvalidFile
+	}
+	signature := make([]byte, header[5])
+	if _, err := io.ReadFull(br, signature); err != nil {
+		return nil, err
+	}
+	var count [4]byte
+	if _, err := io.ReadFull(br, count[:]); err != nil {
+		return nil, err
+	}
+	canonical, _, err := canonicalSignature(string(signature))
+	if err != nil || canonical != string(signature) {
+		return nil, errInvalidFile
+	}
+	m, err := newMaterial(canonical)
+	if err != nil {
+		return nil, err
+	}
+	if int(binary.LittleEndian.Uint32(count[:])) != m.size {
+		return nil, errInvalidFile
+	}
+	t := &Table{m: m, values: make([]uint8, m.size)}
+	if _, err := io.ReadFull(flate.NewReader(br), t.values); err != nil {
+		return nil, fmt.Errorf("tablebase: reading %s: %w", canonical, err)
+	}
+	return t, nil
+}
+
+// Saves the table to a file.
+func (t *Table) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := t.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Loads a table from a file.
+func Load(path string) (*Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadTable(f)
+}
@@ -0,0 +1,320 @@
+package tablebase
+
+// Retrograde solving.
+//
+// Positions are finalized in order of their distance to mate. Checkmates are
+// found first; then, for each distance, the predecessors of the lost
+// positions are won, and the predecessors of the won positions are checked
+// with the move generator, and lost if all their moves lead to won
+// positions. Moves leaving the material (captures and promotions) are looked
+// up in the tables of the resulting materials, generated first.
+// The remaining positions are draws.
+//
+// Tables have no en passant rights: with pawns on both sides, a double push
+// that the opponent may answer by capturing en passant is scored with the
+// captures, looked up in their tables, and the stored value of the position
+// after the push (see enPassant).
+
+import (
+	"fmt"
+
+	"github.com/IlikeChooros/dragontoothmg"
+)
+
+// Generates tables, along with the tables of the materials reachable by
+// captures and promotions.
+type Generator struct {
+	tables map[string]*Table
+}
+
+// Returns a generator without any table.
+func NewGenerator() *Generator {
+	return &Generator{tables: map[string]*Table{}}
+}
+
+// Returns the table of a material signature, generating it first if needed.
+// The signature may have either color as the stronger side.
+func (g *Generator) Generate(signature string) (*Table, error) {
+	signature, _, err := canonicalSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+	if t, ok := g.tables[signature]; ok {
+		return t, nil
+	}
+	m, err := newMaterial(signature)
+	if err != nil {
+		return nil, err
+	}
+	s := &solver{g: g, t: &Table{m: m, values: make([]uint8, m.size)}}
+	if err := s.solve(); err != nil {
+		return nil, err
+	}
+	g.tables[signature] = s.t
+	return s.t, nil
+}
+
+// Returns every table generated so far, as a tablebase.
+func (g *Generator) Tablebase() *Tablebase {
+	tb := New()
+	for _, t := range g.tables {
+		tb.Add(t)
+	}
+	return tb
+}
+
+type solver struct {
+	g        *Generator
+	t        *Table
+	buckets  [][]int32 // Positions to finalize, by distance to mate
+	rechecks [][]int32 // Positions to evaluate again, by distance to mate
+	done     []bool    // Positions whose predecessors were visited
+}
+
+func (s *solver) push(idx, dtm int) error {
+	if dtm > maxDTM+1 {
+		return fmt.Errorf("tablebase: mate too long in %s", s.t.m.signature)
+	}
+	for len(s.buckets) <= dtm {
+		s.buckets = append(s.buckets, nil)
+	}
+	s.buckets[dtm] = append(s.buckets[dtm], int32(idx))
+	return nil
+}
+
+func (s *solver) solve() error {
+	m, values := s.t.m, s.t.values
+	s.done = make([]bool, m.size)
+
+	// Find the illegal positions, the checkmates, and the positions decided
+	// by moves leaving the material
+	for idx := range values {
+		p := m.position(idx)
+		if m.index(&p) == idx && m.legal(&p) {
+			values[idx] = valueUnknown
+		} else {
+			values[idx] = valueIllegal
+			continue
+		}
+		if err := s.evaluate(idx, -1); err != nil {
+			return err
+		}
+	}
+
+	// Retrograde passes, by increasing distance to mate
+	var preds []position
+	for dtm := 0; dtm < len(s.buckets) || dtm < len(s.rechecks); dtm++ {
+		if dtm >= len(s.buckets) {
+			s.buckets = append(s.buckets, nil)
+		}
+		for _, idx := range s.buckets[dtm] {
+			value := lossValue(dtm)
+			if dtm&1 == 1 {
+				value = winValue(dtm)
+				if values[idx] == valueUnknown {
+					values[idx] = value
+				}
+			}
+			if values[idx] != value || s.done[idx] {
+				continue
+			}
+			s.done[idx] = true
+			p := m.position(int(idx))
+			preds = m.unmoves(&p, preds[:0])
+			for i := range preds {
+				predIdx := m.index(&preds[i])
+				if values[predIdx] != valueUnknown {
+					continue
+				}
+				if dtm&1 == 0 {
+					if err := s.win(&preds[i], &p, dtm); err != nil {
+						return err
+					}
+				} else if err := s.evaluate(predIdx, dtm); err != nil {
+					return err
+				}
+			}
+		}
+		s.buckets[dtm] = nil
+		if dtm < len(s.rechecks) {
+			for _, idx := range s.rechecks[dtm] {
+				if values[idx] == valueUnknown {
+					if err := s.evaluate(int(idx), dtm); err != nil {
+						return err
+					}
+				}
+			}
+			s.rechecks[dtm] = nil
+		}
+	}
+
+	for idx, v := range values {
+		if v == valueUnknown {
+			values[idx] = valueDraw
+		}
+	}
+	return nil
+}
+
+// Queues pred, a predecessor of the position p lost in dtm plies, as won in
+// one more ply. A double push allowing en passant captures only wins if the
+// captures lose too.
+func (s *solver) win(pred, p *position, dtm int) error {
+	m := s.t.m
+	for i := range m.pieces {
+		from, to := pred.squares[i], p.squares[i]
+		if from != to && m.allowsEnPassant(pred, i, to) {
+			var mv dragontoothmg.Move
+			mv.Setfrom(dragontoothmg.Square(from)).Setto(dragontoothmg.Square(to))
+			child, _, err := s.child(pred, mv)
+			if err != nil {
+				return err
+			}
+			result := decodeValue(child)
+			if result.Outcome != Loss {
+				return nil
+			}
+			dtm = result.DTM
+		}
+	}
+	return s.push(m.index(pred), dtm+1)
+}
+
+// Looks at the moves of a position with an unknown value, while solving the
+// positions dtm plies from mate (-1 when seeding). The position is lost if
+// every move leads to a won position. When seeding, the moves leaving the
+// material that lead to lost positions are also queued as wins.
+func (s *solver) evaluate(idx int, dtm int) error {
+	seed := dtm < 0
+	m := s.t.m
+	p := m.position(idx)
+	b := m.board(&p)
+	moves := b.GenerateLegalMoves()
+	if len(moves) == 0 {
+		if b.OurKingInCheck() {
+			s.t.values[idx] = lossValue(0)
+			return s.push(idx, 0)
+		}
+		s.t.values[idx] = valueDraw
+		return nil
+	}
+
+	allWins, longestWin, recheck := true, 0, 0
+	for _, mv := range moves {
+		child, internal, err := s.child(&p, mv)
+		if err != nil {
+			return err
+		}
+		result := decodeValue(child)
+		if internal && child != valueUnknown && result.Outcome == Win && result.DTM > dtm {
+			// Won by an en passant capture, unless the position after the
+			// push is won sooner: look again once it would be known
+			allWins = false
+			recheck = max(recheck, result.DTM)
+			continue
+		}
+		if internal && seed {
+			allWins = false
+			continue
+		}
+		if child == valueUnknown || result.Outcome != Win {
+			allWins = false
+		} else if result.DTM > longestWin {
+			longestWin = result.DTM
+		}
+		if seed && !internal && result.Outcome == Loss {
+			if err := s.push(idx, result.DTM+1); err != nil {
+				return err
+			}
+		}
+	}
+	if allWins {
+		s.t.values[idx] = lossValue(longestWin + 1)
+		return s.push(idx, longestWin+1)
+	}
+	if recheck > 0 {
+		for len(s.rechecks) <= recheck {
+			s.rechecks = append(s.rechecks, nil)
+		}
+		s.rechecks[recheck] = append(s.rechecks[recheck], int32(idx))
+	}
+	return nil
+}
+
+// Returns the value of the position after a move, and whether it has the
+// same material.
+func (s *solver) child(p *position, mv dragontoothmg.Move) (uint8, bool, error) {
+	m := s.t.m
+	from, to := int(mv.From()), int(mv.To())
+	next := *p
+	next.wtm = !p.wtm
+	captured, moved := false, 0
+	for i := range m.pieces {
+		if p.squares[i] == to {
+			captured = true
+		}
+		if p.squares[i] == from {
+			next.squares[i] = to
+			moved = i
+		}
+	}
+	if !captured && mv.Promote() == dragontoothmg.Nothing {
+		value := s.t.value(&next)
+		if m.allowsEnPassant(p, moved, to) {
+			value, err := s.enPassant(p, mv, value)
+			return value, true, err
+		}
+		return value, true, nil
+	}
+
+	// The material changes: look up the other table
+	b := m.board(p)
+	b.Apply(mv)
+	signature, flip, err := canonicalSignature(b.MaterialSignature())
+	if err != nil {
+		return 0, false, err
+	}
+	other, err := s.g.Generate(signature)
+	if err != nil {
+		return 0, false, err
+	}
+	childPos := other.m.boardPosition(&b, flip)
+	return other.value(&childPos), false, nil
+}
+
+// Returns the value of the position after a double push from p, given its
+// stored value, which ignores the opponent's en passant captures. The
+// opponent picks the best of the captures and the stored value. While the
+// stored value is unknown, only a winning capture is returned: the position
+// after the push may still be won sooner (see evaluate).
+func (s *solver) enPassant(p *position, mv dragontoothmg.Move, stored uint8) (uint8, error) {
+	b := s.t.m.board(p)
+	b.Apply(mv)
+	best, found := Result{}, false
+	for _, capture := range enPassantCaptures(&b) {
+		after := s.t.m.board(p)
+		after.Apply(mv)
+		after.Apply(capture)
+		signature, flip, err := canonicalSignature(after.MaterialSignature())
+		if err != nil {
+			return 0, err
+		}
+		other, err := s.g.Generate(signature)
+		if err != nil {
+			return 0, err
+		}
+		childPos := other.m.boardPosition(&after, flip)
+		if result := previous(decodeValue(other.value(&childPos))); !found || better(result, best) {
+			best, found = result, true
+		}
+	}
+	switch {
+	case !found:
+		return stored, nil
+	case stored == valueUnknown && best.Outcome != Win:
+		return valueUnknown, nil
+	case stored != valueUnknown && better(decodeValue(stored), best):
+		return stored, nil
+	}
+	return best.value(), nil
+}
@@ -0,0 +1,51 @@
+// Command gentb generates endgame tables, along with the tables of the
+// materials they convert to, and writes them to a directory.
+//
+// Usage: gentb [-dir path] KQvK KRvK KPvK ...
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/IlikeChooros/dragontoothmg/tablebase"
+)
+
+var dir = flag.String("dir", ".", "directory to write the tables to")
+
+func main() {
+	flag.Parse()
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: gentb [-dir path] signature...")
+		os.Exit(2)
+	}
+	if err := os.MkdirAll(*dir, 0o755); err != nil {
+		log.Fatal(err)
+	}
+
+	g := tablebase.NewGenerator()
+	for _, signature := range flag.Args() {
+		start := time.Now()
+		t, err := g.Generate(signature)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%-8s generated in %v\n", t.Signature(), time.Since(start).Round(time.Millisecond))
+	}
+
+	tb := g.Tablebase()
+	signatures := tb.Signatures()
+	sort.Strings(signatures)
+	for _, signature := range signatures {
+		t, _ := g.Generate(signature)
+		if err := t.Save(filepath.Join(*dir, tablebase.FileName(signature))); err != nil {
+			log.Fatal(err)
+		}
+	}
+	fmt.Println("wrote", len(signatures), "tables to", *dir)
+}
@@ -0,0 +1,341 @@
+package tablebase
+
+// Indexing of positions by material.
+//
+// A position is indexed by the side to move, the square of the white king,
+// and the squares of the other pieces, in the order of the material
+// signature. Symmetry is used to place the white king in the a1-d1-d4
+// triangle (10 squares) for pawnless materials, and in the a-d files (32
+// squares) otherwise. Identical pieces take one index per permutation.
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/IlikeChooros/dragontoothmg"
+)
+
+// Maximum number of pieces, kings included
+const MaxPieces = 4
+
+// Set on the piece codes of black pieces
+const blackBit = 8
+
+// Square transformations, applied in this order
+const (
+	flipFile  = 1
+	flipRank  = 2
+	transpose = 4
+)
+
+// Index of each king square, or -1 if the king is never found there.
+var kingIndexPawnless, kingIndexPawns [64]int
+
+// Square of each king index
+var kingSquaresPawnless, kingSquaresPawns []int
+
+func init() {
+	for sq := 0; sq < 64; sq++ {
+		kingIndexPawnless[sq], kingIndexPawns[sq] = -1, -1
+		file, rank := sq&7, sq>>3
+		if file <= 3 && rank <= file {
+			kingIndexPawnless[sq] = len(kingSquaresPawnless)
+			kingSquaresPawnless = append(kingSquaresPawnless, sq)
+		}
+		if file <= 3 {
+			kingIndexPawns[sq] = len(kingSquaresPawns)
+			kingSquaresPawns = append(kingSquaresPawns, sq)
+		}
+	}
+}
+
+// A material signature, with white as the stronger side
+type material struct {
+	signature string
+	pieces    []uint8 // Piece codes in signature order, the white king first
+	hasPawns  bool
+	size      int // Number of indices
+}
+
+// Piece letters in signature order
+const pieceLetters = "KQRBNP"
+
+// Piece code of each letter
+var letterPieces = map[rune]uint8{
+	'K': dragontoothmg.King, 'Q': dragontoothmg.Queen, 'R': dragontoothmg.Rook,
+	'B': dragontoothmg.Bishop, 'N': dragontoothmg.Knight, 'P': dragontoothmg.Pawn,
+}
+
+// Piece values deciding the stronger side
+var letterValues = map[rune]int{'K': 0, 'Q': 9, 'R': 5, 'B': 3, 'N': 3, 'P': 1}
+
+// Sorts the pieces of one side in signature order.
+func sortSide(side string) string {
+	var sorted strings.Builder
+	for _, c := range pieceLetters {
+		sorted.WriteString(strings.Repeat(string(c), strings.Count(side, string(c))))
+	}
+	return sorted.String()
+}
+
+// Returns whether the side given first is stronger than the other,
+// by material value, then by number of pieces, then by piece order.
+func stronger(a, b string) bool {
+	valueA, valueB := 0, 0
+	for _, c := range a {
+		valueA += letterValues[c]
+	}
+	for _, c := range b {
+		valueB += letterValues[c]
+	}
+	if valueA != valueB {
+		return valueA > valueB
+	}
+	if len(a) != len(b) {
+		return len(a) > len(b)
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return strings.IndexByte(pieceLetters, a[i]) < strings.IndexByte(pieceLetters, b[i])
+		}
+	}
+	return false
+}
+
+// Returns the canonical form of a signature such as "KvKR", with the
+// stronger side as white ("KRvK"), and whether the colors were swapped.
+func canonicalSignature(signature string) (string, bool, error) {
+	white, black, ok := strings.Cut(signature, "v")
+	if !ok || strings.Count(white, "K") != 1 || strings.Count(black, "K") != 1 ||
+		strings.Trim(white+black, pieceLetters) != "" {
+		return "", false, fmt.Errorf("tablebase: invalid material signature %q", signature)
+	}
+	white, black = sortSide(white), sortSide(black)
+	if stronger(black, white) {
+		return black + "v" + white, true, nil
+	}
+	return white + "v" + black, false, nil
+}
+
+// Parses a canonical material signature.
+func newMaterial(signature string) (*material, error) {
+	white, black, _ := strings.Cut(signature, "v")
+	if len(white)+len(black) > MaxPieces {
+		return nil, fmt.Errorf("tablebase: too many pieces in %q", signature)
+	}
+	m := &material{signature: signature}
+	for side, pieces := range [2]string{white, black} {
+		for _, c := range pieces {
+			p := letterPieces[c]
+			if side == 1 {
+				p |= blackBit
+			}
+			m.pieces = append(m.pieces, p)
+		}
+	}
+	m.hasPawns = strings.Contains(signature, "P")
+	m.size = 2 * len(m.kingSquares())
+	for range m.pieces[1:] {
+		m.size *= 64
+	}
+	return m, nil
+}
+
+func (m *material) kingSquares() []int {
+	if m.hasPawns {
+		return kingSquaresPawns
+	}
+	return kingSquaresPawnless
+}
+
+func (m *material) kingIndex(sq int) int {
+	if m.hasPawns {
+		return kingIndexPawns[sq]
+	}
+	return kingIndexPawnless[sq]
+}
+
+// A placement of the pieces of a material, in the same order
+type position struct {
+	squares [MaxPieces]int
+	wtm     bool
+}
+
+// Returns the transformation that brings the white king to its indexed squares.
+func (m *material) symmetry(kingSq int) int {
+	t := 0
+	if kingSq&7 > 3 {
+		t |= flipFile
+		kingSq ^= 7
+	}
+	if m.hasPawns {
+		return t
+	}
+	if kingSq>>3 > 3 {
+		t |= flipRank
+		kingSq ^= 56
+	}
+	if kingSq>>3 > kingSq&7 {
+		t |= transpose
+	}
+	return t
+}
+
+func transformSquare(sq, t int) int {
+	if t&flipFile != 0 {
+		sq ^= 7
+	}
+	if t&flipRank != 0 {
+		sq ^= 56
+	}
+	if t&transpose != 0 {
+		sq = sq>>3 | (sq&7)<<3
+	}
+	return sq
+}
+
+// Returns the index of a position. Without pawns, a white king on the a1-h8
+// diagonal leaves two placements of the other pieces, mirrored by the
+// diagonal; the smaller index is used.
+func (m *material) index(p *position) int {
+	t := m.symmetry(p.squares[0])
+	idx := m.transformedIndex(p, t)
+	if king := transformSquare(p.squares[0], t); !m.hasPawns && king>>3 == king&7 {
+		idx = min(idx, m.transformedIndex(p, t^transpose))
+	}
+	return idx
+}
+
+func (m *material) transformedIndex(p *position, t int) int {
+	idx := m.kingIndex(transformSquare(p.squares[0], t))
+	if !p.wtm {
+		idx += len(m.kingSquares())
+	}
+	for i := 1; i < len(m.pieces); i++ {
+		idx = idx*64 + transformSquare(p.squares[i], t)
+	}
+	return idx
+}
+
+// Returns the position of an index. It may not be a legal one.
+func (m *material) position(idx int) position {
+	var p position
+	for i := len(m.pieces) - 1; i > 0; i-- {
+		p.squares[i] = idx % 64
+		idx /= 64
+	}
+	kings := len(m.kingSquares())
+	p.wtm = idx < kings
+	p.squares[0] = m.kingSquares()[idx%kings]
+	return p
+}
+
+// Returns the bitboard of the squares occupied in p.
+func (m *material) occupied(p *position) uint64 {
+	var occupied uint64
+	for i := range m.pieces {
+		occupied |= uint64(1) << p.squares[i]
+	}
+	return occupied
+}
+
+// Returns whether the pieces are on distinct squares, with no pawn on the
+// first or last rank, and the side that just moved not in check.
+func (m *material) legal(p *position) bool {
+	if bits.OnesCount64(m.occupied(p)) != len(m.pieces) {
+		return false
+	}
+	for i, piece := range m.pieces {
+		if piece&^blackBit == dragontoothmg.Pawn && (p.squares[i] < 8 || p.squares[i] >= 56) {
+			return false
+		}
+	}
+	b := m.board(p)
+	oppKing := b.Black.Kings
+	if !p.wtm {
+		oppKing = b.White.Kings
+	}
+	return !b.UnderDirectAttack(!p.wtm, uint8(bits.TrailingZeros64(oppKing)))
+}
+
+// Returns whether moving piece i of p to the square to is a double push
+// next to an opposing pawn, which may then capture en passant.
+func (m *material) allowsEnPassant(p *position, i, to int) bool {
+	from := p.squares[i]
+	if m.pieces[i]&^blackBit != dragontoothmg.Pawn || (from-to != 16 && to-from != 16) {
+		return false
+	}
+	for j, piece := range m.pieces {
+		sq := p.squares[j]
+		if piece == m.pieces[i]^blackBit && sq>>3 == to>>3 && (sq-to == 1 || to-sq == 1) {
+			return true
+		}
+	}
+	return false
+}
+
+// Builds a board out of a position, without castling or en passant rights.
+func (m *material) board(p *position) dragontoothmg.Board {
+	b := dragontoothmg.Board{Wtomove: p.wtm}
+	for i, piece := range m.pieces {
+		bb := &b.White
+		if piece&blackBit != 0 {
+			bb = &b.Black
+		}
+		mask := uint64(1) << p.squares[i]
+		switch piece &^ blackBit {
+		case dragontoothmg.Pawn:
+			bb.Pawns |= mask
+		case dragontoothmg.Knight:
+			bb.Knights |= mask
+		case dragontoothmg.Bishop:
+			bb.Bishops |= mask
+		case dragontoothmg.Rook:
+			bb.Rooks |= mask
+		case dragontoothmg.Queen:
+			bb.Queens |= mask
+		case dragontoothmg.King:
+			bb.Kings |= mask
+		}
+		bb.All |= mask
+	}
+	b.SyncMailbox()
+	return b
+}
+
+// Returns the position of a board with this material. If flip is set, the
+// colors are swapped and the board mirrored vertically. Identical pieces
+// are placed in increasing square order.
+func (m *material) boardPosition(b *dragontoothmg.Board, flip bool) position {
+	p := position{wtm: b.Wtomove != flip}
+	var used uint64
+	for i, piece := range m.pieces {
+		bb := &b.White
+		if (piece&blackBit != 0) != flip {
+			bb = &b.Black
+		}
+		var pieceBB uint64
+		switch piece &^ blackBit {
+		case dragontoothmg.Pawn:
+			pieceBB = bb.Pawns
+		case dragontoothmg.Knight:
+			pieceBB = bb.Knights
+		case dragontoothmg.Bishop:
+			pieceBB = bb.Bishops
+		case dragontoothmg.Rook:
+			pieceBB = bb.Rooks
+		case dragontoothmg.Queen:
+			pieceBB = bb.Queens
+		case dragontoothmg.King:
+			pieceBB = bb.Kings
+		}
+		if flip {
+			pieceBB = bits.ReverseBytes64(pieceBB)
+		}
+		sq := bits.TrailingZeros64(pieceBB &^ used)
+		used |= uint64(1) << sq
+		p.squares[i] = sq
+	}
+	return p
+}
@@ -0,0 +1,257 @@
+// Package tablebase generates and probes exact endgame tables for small
+// material sets, such as KQvK, KRvK, KPvK or KBNvK.
+//
+// Tables are solved by retrograde analysis with the dragontoothmg move
+// generator, and store for every position the outcome with best play and
+// the distance to mate, in plies. The 50-move rule is not taken into
+// account. Positions with castling rights are not stored. Positions are
+// stored without en passant rights; Tablebase.Probe looks at the en passant
+// captures of the positions that have them.
+package tablebase
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/IlikeChooros/dragontoothmg"
+)
+
+// Outcome of a position for the side to move, with best play
+type Outcome int8
+
+const (
+	Loss Outcome = -1
+	Draw Outcome = 0
+	Win  Outcome = 1
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Loss:
+		return "loss"
+	case Draw:
+		return "draw"
+	case Win:
+		return "win"
+	}
+	return "unknown"
+}
+
+// The result of a probe
+type Result struct {
+	Outcome Outcome
+	// Number of plies until mate: odd for wins, even for losses (zero for a
+	// checkmated side to move), and zero for draws.
+	DTM int
+}
+
+var (
+	// The material of the position has no table
+	ErrMissingTable = errors.New("tablebase: missing table")
+	// Tables don't store positions with castling rights
+	ErrCastlingRights = errors.New("tablebase: position has castling rights")
+	// Tables don't store positions with en passant captures
+	ErrEnPassant = errors.New("tablebase: position has en passant captures")
+)
+
+// Encoding of the stored values: draws are zero, wins are the odd number of
+// plies to mate, and losses the even number of plies to mate, plus two.
+const (
+	valueDraw    = 0
+	valueUnknown = 254 // Only while solving
+	valueIllegal = 255
+	maxDTM       = 250
+)
+
+func winValue(dtm int) uint8 {
+	return uint8(dtm)
+}
+
+func lossValue(dtm int) uint8 {
+	return uint8(dtm + 2)
+}
+
+func decodeValue(v uint8) Result {
+	switch {
+	case v == valueDraw || v >= valueUnknown:
+		return Result{Draw, 0}
+	case v&1 == 1:
+		return Result{Win, int(v)}
+	}
+	return Result{Loss, int(v) - 2}
+}
+
+func (r Result) value() uint8 {
+	switch r.Outcome {
+	case Win:
+		return winValue(r.DTM)
+	case Loss:
+		return lossValue(r.DTM)
+	}
+	return valueDraw
+}
+
+// Returns the result of the side that moved into a position with result r.
+func previous(r Result) Result {
+	switch r.Outcome {
+	case Win:
+		return Result{Loss, r.DTM + 1}
+	case Loss:
+		return Result{Win, r.DTM + 1}
+	}
+	return r
+}
+
+// Returns whether a is better than b for the side to move: wins first, the
+// shortest ones first, then draws, then losses, the longest ones first.
+func better(a, b Result) bool {
+	if a.Outcome != b.Outcome {
+		return a.Outcome > b.Outcome
+	}
+	switch a.Outcome {
+	case Win:
+		return a.DTM < b.DTM
+	case Loss:
+		return a.DTM > b.DTM
+	}
+	return false
+}
+
+// Returns the legal en passant captures of a board.
+func enPassantCaptures(b *dragontoothmg.Board) []dragontoothmg.Move {
+	if b.White.Pawns == 0 || b.Black.Pawns == 0 {
+		return nil
+	}
+	var captures []dragontoothmg.Move
+	occupied := b.White.All | b.Black.All
+	for _, mv := range b.GenerateLegalMoves() {
+		from, to := mv.From(), mv.To()
+		if piece, _ := b.PieceAt(dragontoothmg.Square(from)); piece == dragontoothmg.Pawn &&
+			from&7 != to&7 && occupied&(uint64(1)<<to) == 0 {
+			captures = append(captures, mv)
+		}
+	}
+	return captures
+}
+
+// A solved material
+type Table struct {
+	m      *material
+	values []uint8 // Indexed by position
+}
+
+// Returns the material signature of the table, with white as the stronger side.
+func (t *Table) Signature() string {
+	return t.m.signature
+}
+
+// Probes a position with the material of the table, with either color as
+// the stronger side. Positions with en passant captures lead to other
+// tables: use Tablebase.Probe for them.
+func (t *Table) Probe(b *dragontoothmg.Board) (Result, error) {
+	result, err := t.probe(b)
+	if err == nil && len(enPassantCaptures(b)) != 0 {
+		return Result{}, ErrEnPassant
+	}
+	return result, err
+}
+
+// Probes a position, ignoring its en passant rights.
+func (t *Table) probe(b *dragontoothmg.Board) (Result, error) {
+	signature, flip, err := canonicalSignature(b.MaterialSignature())
+	if err != nil {
+		return Result{}, err
+	}
+	if signature != t.m.signature {
+		return Result{}, fmt.Errorf("tablebase: position with material %s probed in table %s",
+			b.MaterialSignature(), t.m.signature)
+	}
+	if b.CanCastleKingside() || b.CanCastleQueenside() ||
+		b.OppCanCastleKingside() || b.OppCanCastleQueenside() {
+		return Result{}, ErrCastlingRights
+	}
+	p := t.m.boardPosition(b, flip)
+	return decodeValue(t.values[t.m.index(&p)]), nil
+}
+
+// Returns the value of a position, from the point of view of its side to move.
+func (t *Table) value(p *position) uint8 {
+	return t.values[t.m.index(p)]
+}
+
+// A set of tables, by material
+type Tablebase struct {
+	tables map[string]*Table
+}
+
+// Returns an empty set of tables.
+func New() *Tablebase {
+	return &Tablebase{tables: map[string]*Table{}}
+}
+
+// Loads every table file (with the FileExtension extension) in dir.
+func Open(dir string) (*Tablebase, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+FileExtension))
+	if err != nil {
+		return nil, err
+	}
+	tb := New()
+	for _, path := range paths {
+		t, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		tb.Add(t)
+	}
+	return tb, nil
+}
+
+// Adds a table, replacing any table of the same material.
+func (tb *Tablebase) Add(t *Table) {
+	tb.tables[t.Signature()] = t
+}
+
+// Returns the material signatures of the tables, with white as the stronger side.
+func (tb *Tablebase) Signatures() []string {
+	signatures := make([]string, 0, len(tb.tables))
+	for signature := range tb.tables {
+		signatures = append(signatures, signature)
+	}
+	return signatures
+}
+
+// Probes a position in the table of its material. The en passant captures
+// are probed in the tables of their materials, and the best of them is
+// returned if it is better than the position without en passant rights.
+func (tb *Tablebase) Probe(b *dragontoothmg.Board) (Result, error) {
+	signature, _, err := canonicalSignature(b.MaterialSignature())
+	if err != nil {
+		return Result{}, err
+	}
+	t, ok := tb.tables[signature]
+	if !ok {
+		return Result{}, fmt.Errorf("%w: %s", ErrMissingTable, signature)
+	}
+	best, err := t.probe(b)
+	if err != nil {
+		return Result{}, err
+	}
+	for _, capture := range enPassantCaptures(b) {
+		after := b.Clone()
+		after.Apply(capture)
+		result, err := tb.Probe(after)
+		if err != nil {
+			return Result{}, err
+		}
+		if result = previous(result); better(result, best) {
+			best = result
+		}
+	}
+	return best, nil
+}
+
+// Returns the file name of the table of a signature, such as "KQvK.dtb".
+func FileName(signature string) string {
+	return signature + FileExtension
+}
@@ -0,0 +1,350 @@
+package tablebase
+
+import (
+	"bytes"
+	"errors"
+	"math/bits"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IlikeChooros/dragontoothmg"
+	"github.com/IlikeChooros/dragontoothmg/syzygy"
+)
+
+// Longest mates with white and black to move
+func longestMates(t *Table) (int, int) {
+	white, black := 0, 0
+	for idx, v := range t.values {
+		if v == valueIllegal {
+			continue
+		}
+		r := decodeValue(v)
+		p := t.m.position(idx)
+		if p.wtm && r.Outcome == Win && r.DTM > white {
+			white = r.DTM
+		} else if !p.wtm && r.Outcome == Loss && r.DTM > black {
+			black = r.DTM
+		}
+	}
+	return white, black
+}
+
+func TestLongestMates(t *testing.T) {
+	g := NewGenerator()
+	tests := map[string][2]int{
+		"KQvK": {19, 20}, // Mate in 10
+		"KRvK": {31, 32}, // Mate in 16
+	}
+	for signature, expected := range tests {
+		table, err := g.Generate(signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		white, black := longestMates(table)
+		if white != expected[0] || black != expected[1] {
+			t.Error("Longest mates in", signature, "are", white, black, "expected", expected)
+		}
+	}
+}
+
+func TestProbe(t *testing.T) {
+	g := NewGenerator()
+	if _, err := g.Generate("KPvK"); err != nil {
+		t.Fatal(err)
+	}
+	tb := g.Tablebase()
+	tests := []struct {
+		fen    string
+		result Result
+	}{
+		{"k7/8/1K6/8/8/8/7Q/8 w - - 0 1", Result{Win, 1}},
+		{"8/8/8/8/8/2k5/1q6/K7 w - - 0 1", Result{Loss, 0}},
+		{"k7/2Q5/1K6/8/8/8/8/8 b - - 0 1", Result{Draw, 0}},
+		{"7k/8/8/8/8/8/7P/7K w - - 0 1", Result{Draw, 0}},
+		{"8/8/8/8/8/8/8/K6k w - - 0 1", Result{Draw, 0}},
+		{"8/8/8/8/8/8/8/KN5k w - - 0 1", Result{Draw, 0}},
+	}
+	for _, test := range tests {
+		b := dragontoothmg.ParseFen(test.fen)
+		result, err := tb.Probe(&b)
+		if err != nil {
+			t.Error(test.fen, err)
+		} else if result != test.result {
+			t.Error("Wrong result in", test.fen, "expected", test.result, "but got", result)
+		}
+	}
+}
+
+// Checks every position against a one ply search over its children, and
+// the outcomes against the Syzygy test tables. With TABLEBASE_SLOW set,
+// KPvKP is checked too, which takes over an hour.
+func TestConsistency(t *testing.T) {
+	g := NewGenerator()
+	sz, err := syzygy.Open(filepath.Join("..", "syzygy", "testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signatures := []string{"KQvK", "KRvK", "KPvK"}
+	if os.Getenv("TABLEBASE_SLOW") != "" {
+		signatures = append(signatures, "KPvKP")
+	}
+	for _, signature := range signatures {
+		table, err := g.Generate(signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tb := g.Tablebase()
+		for idx, v := range table.values {
+			if v == valueIllegal {
+				continue
+			}
+			p := table.m.position(idx)
+			b := table.m.board(&p)
+			result := decodeValue(v)
+			if expected := searchResult(t, tb, &b); result != expected {
+				t.Fatal("Inconsistent result in", b.ToFen(), "stored", result, "but the search gives", expected)
+			}
+			if signature == "KPvKP" {
+				continue // No Syzygy test table
+			}
+			wdl, err := sz.ProbeWDL(&b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if Outcome(wdl/2) != result.Outcome {
+				t.Fatal("Outcome of", b.ToFen(), "is", result.Outcome, "but Syzygy gives", wdl)
+			}
+		}
+	}
+}
+
+func searchResult(t *testing.T, tb *Tablebase, b *dragontoothmg.Board) Result {
+	moves := b.GenerateLegalMoves()
+	if len(moves) == 0 {
+		if b.OurKingInCheck() {
+			return Result{Loss, 0}
+		}
+		return Result{Draw, 0}
+	}
+	best := Result{Loss, 0}
+	for _, mv := range moves {
+		child := *b
+		child.History = nil
+		child.Apply(mv)
+		var r Result
+		if bits.OnesCount64(child.White.All|child.Black.All) > 2 {
+			var err error
+			if r, err = tb.Probe(&child); err != nil {
+				t.Fatal(err)
+			}
+		}
+		switch {
+		case r.Outcome == Loss && (best.Outcome != Win || r.DTM+1 < best.DTM):
+			best = Result{Win, r.DTM + 1}
+		case r.Outcome == Draw && best.Outcome == Loss:
+			best = Result{Draw, 0}
+		case r.Outcome == Win && best.Outcome == Loss && r.DTM+1 > best.DTM:
+			best = Result{Loss, r.DTM + 1}
+		}
+	}
+	return best
+}
+
+// Checks the un-moves against the move generator, for a sample of positions
+// with four pieces.
+func TestUnmoves(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, signature := range []string{"KBNvK", "KRvKN", "KQvKP", "KPPvK"} {
+		m, err := newMaterial(signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for checked := 0; checked < 2000; {
+			p := m.position(r.Intn(m.size))
+			if !m.legal(&p) {
+				continue
+			}
+			checked++
+			idx := m.index(&p)
+			for _, pred := range m.unmoves(&p, nil) {
+				if !hasMoveTo(m, &pred, idx) {
+					b := m.board(&pred)
+					t.Error("Un-move to", b.ToFen(), "has no move back")
+				}
+			}
+			b := m.board(&p)
+			for _, mv := range b.GenerateLegalMoves() {
+				child := p
+				child.wtm = !p.wtm
+				if mv.Promote() != dragontoothmg.Nothing || m.occupied(&p)&(uint64(1)<<mv.To()) != 0 {
+					continue
+				}
+				for i := range m.pieces {
+					if p.squares[i] == int(mv.From()) {
+						child.squares[i] = int(mv.To())
+					}
+				}
+				found := false
+				for _, pred := range m.unmoves(&child, nil) {
+					found = found || m.index(&pred) == idx
+				}
+				if !found {
+					t.Error("Move", &mv, "in", b.ToFen(), "has no un-move back")
+				}
+			}
+		}
+	}
+}
+
+func hasMoveTo(m *material, p *position, idx int) bool {
+	b := m.board(p)
+	for _, mv := range b.GenerateLegalMoves() {
+		child := *p
+		child.wtm = !p.wtm
+		for i := range m.pieces {
+			if p.squares[i] == int(mv.From()) {
+				child.squares[i] = int(mv.To())
+			}
+		}
+		if mv.Promote() == dragontoothmg.Nothing && m.index(&child) == idx {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSaveLoad(t *testing.T) {
+	g := NewGenerator()
+	table, err := g.Generate("KvKQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Signature() != "KQvK" {
+		t.Error("Expected the KQvK table, got", table.Signature())
+	}
+	var buf bytes.Buffer
+	if _, err := table.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() > len(table.values)/4 {
+		t.Error("Table file of", buf.Len(), "bytes is not compact")
+	}
+	read, err := ReadTable(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Signature() != table.Signature() || !bytes.Equal(read.values, table.values) {
+		t.Error("Table changed by saving and loading")
+	}
+
+	dir := t.TempDir()
+	if err := table.Save(filepath.Join(dir, FileName(table.Signature()))); err != nil {
+		t.Fatal(err)
+	}
+	tb, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := dragontoothmg.ParseFen("8/8/8/8/8/2k5/1q6/K7 w - - 0 1")
+	if result, err := tb.Probe(&b); err != nil || result != (Result{Loss, 0}) {
+		t.Error("Probe after loading gave", result, err)
+	}
+	b = dragontoothmg.ParseFen("8/8/8/8/8/2k5/1r6/K7 w - - 0 1")
+	if _, err := tb.Probe(&b); !errors.Is(err, ErrMissingTable) {
+		t.Error("Expected a missing table error, got", err)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	g := NewGenerator()
+	for _, signature := range []string{"KQRvKR", "KQvQ", "KXvK", "KQK"} {
+		if _, err := g.Generate(signature); err == nil {
+			t.Error("Expected an error generating", signature)
+		}
+	}
+	table, err := g.Generate("KRvK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := dragontoothmg.ParseFen("4k3/8/8/8/8/8/8/4K2R w K - 0 1")
+	if _, err := table.Probe(&b); !errors.Is(err, ErrCastlingRights) {
+		t.Error("Expected a castling rights error, got", err)
+	}
+	b = dragontoothmg.ParseFen("4k3/8/8/8/8/8/8/4K2Q w - - 0 1")
+	if _, err := table.Probe(&b); err == nil {
+		t.Error("Expected an error probing KQvK in the KRvK table")
+	}
+}
+
+// Checks that double pushes are scored with the en passant captures, in a
+// KPvKP table stored with the given values.
+func TestEnPassant(t *testing.T) {
+	g := NewGenerator()
+	if _, err := g.Generate("KPvK"); err != nil {
+		t.Fatal(err)
+	}
+	m, err := newMaterial("KPvKP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := &Table{m: m, values: make([]uint8, m.size)}
+	s := &solver{g: g, t: table}
+	tests := []struct {
+		fen, move string
+		stored    uint8
+		expected  Result
+	}{
+		// dxe3 promotes: a win, even before the position after e4 is known
+		{"K7/8/8/8/3p4/8/4P3/7k w - - 0 1", "e2e4", valueUnknown, Result{Win, 23}},
+		{"K7/8/8/8/3p4/8/4P3/7k w - - 0 1", "e2e4", lossValue(0), Result{Win, 23}},
+		{"K7/8/8/8/3p4/8/4P3/7k w - - 0 1", "e2e4", winValue(3), Result{Win, 3}},
+		// Ke2 wins the pawn after dxe3
+		{"7k/8/8/8/3p4/8/4P3/5K2 w - - 0 1", "e2e4", valueUnknown, decodeValue(valueUnknown)},
+		{"7k/8/8/8/3p4/8/4P3/5K2 w - - 0 1", "e2e4", lossValue(10), Result{Draw, 0}},
+		{"7k/8/8/8/3p4/8/4P3/5K2 w - - 0 1", "e2e4", winValue(3), Result{Win, 3}},
+		// Not a double push
+		{"7k/8/8/8/8/3p4/4P3/5K2 w - - 0 1", "e2e3", lossValue(4), Result{Loss, 4}},
+	}
+	for _, test := range tests {
+		b := dragontoothmg.ParseFen(test.fen)
+		p := m.boardPosition(&b, false)
+		mv, err := dragontoothmg.ParseMove(test.move)
+		if err != nil {
+			t.Fatal(err)
+		}
+		next := p
+		next.wtm = false
+		for i := range m.pieces {
+			if p.squares[i] == int(mv.From()) {
+				next.squares[i] = int(mv.To())
+			}
+		}
+		table.values[m.index(&next)] = test.stored
+		child, internal, err := s.child(&p, mv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := decodeValue(child); !internal || result != test.expected {
+			t.Error("Wrong value after", &mv, "in", test.fen, "expected", test.expected, "but got", result)
+		}
+		if test.stored == valueUnknown && child != valueUnknown && test.expected.Outcome != Win {
+			t.Error("Expected an unknown value after", &mv, "in", test.fen)
+		}
+	}
+
+	// Probing looks at the captures as well
+	for i := range table.values {
+		table.values[i] = valueDraw
+	}
+	tb := g.Tablebase()
+	tb.Add(table)
+	b := dragontoothmg.ParseFen("K7/8/8/8/3pP3/8/8/7k b - e3 0 1")
+	if _, err := table.Probe(&b); !errors.Is(err, ErrEnPassant) {
+		t.Error("Expected an en passant error, got", err)
+	}
+	if result, err := tb.Probe(&b); err != nil || result != (Result{Win, 23}) {
+		t.Error("Probe with en passant gave", result, err)
+	}
+}
@@ -0,0 +1,72 @@
+package tablebase
+
+// Generation of un-moves: the moves that lead to a position, for the
+// retrograde passes. Only moves that keep the material are generated, so
+// there are no uncaptures and no unpromotions.
+//
+// Board.GenerateUnmoves isn't used here: it works on boards, and also
+// generates the uncaptures, unpromotions and castling reversals, which
+// lead out of the table. The retrograde passes visit every position of the
+// table, so they stay on packed positions, and skip the board and the
+// unmoves that would be filtered out.
+
+import (
+	"math/bits"
+
+	"github.com/IlikeChooros/dragontoothmg"
+)
+
+// Appends to buf the legal positions from which the side that just moved
+// could have reached p with a move that is not a capture or a promotion.
+func (m *material) unmoves(p *position, buf []position) []position {
+	occupied := m.occupied(p)
+	moverIsBlack := p.wtm
+	for i, piece := range m.pieces {
+		if (piece&blackBit != 0) != moverIsBlack {
+			continue
+		}
+		sq := p.squares[i]
+		var origins uint64
+		switch piece &^ blackBit {
+		case dragontoothmg.Pawn:
+			origins = pawnOrigins(sq, moverIsBlack, occupied)
+		case dragontoothmg.Knight:
+			origins = dragontoothmg.CalculateKnightMoveBitboard(uint8(sq))
+		case dragontoothmg.Bishop:
+			origins = dragontoothmg.CalculateBishopMoveBitboard(uint8(sq), occupied)
+		case dragontoothmg.Rook:
+			origins = dragontoothmg.CalculateRookMoveBitboard(uint8(sq), occupied)
+		case dragontoothmg.Queen:
+			origins = dragontoothmg.CalculateBishopMoveBitboard(uint8(sq), occupied) |
+				dragontoothmg.CalculateRookMoveBitboard(uint8(sq), occupied)
+		case dragontoothmg.King:
+			origins = dragontoothmg.CalculateKingMoveBitboard(uint8(sq))
+		}
+		for origins &^= occupied; origins != 0; origins &= origins - 1 {
+			prev := *p
+			prev.wtm = !p.wtm
+			prev.squares[i] = bits.TrailingZeros64(origins)
+			if m.legal(&prev) {
+				buf = append(buf, prev)
+			}
+		}
+	}
+	return buf
+}
+
+// Returns the squares a pawn on sq could have come from with a push.
+func pawnOrigins(sq int, black bool, occupied uint64) uint64 {
+	back, doubleRank := -8, 3
+	if black {
+		back, doubleRank = 8, 4
+	}
+	single := sq + back
+	if single>>3 == 0 || single>>3 == 7 || occupied&(uint64(1)<<single) != 0 {
+		return 0
+	}
+	origins := uint64(1) << single
+	if sq>>3 == doubleRank && occupied&(uint64(1)<<(single+back)) == 0 {
+		origins |= uint64(1) << (single + back)
+	}
+	return origins
+}
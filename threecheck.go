@@ -0,0 +1,98 @@
+package dragontoothmg
+
+// Three-check support. The game is also won by giving check three times.
+// The checks each side still has to give are part of the position: in FEN they
+// are written either as a "3+3" field after the en passant square (checks left
+// for white and black), or as the lichess "+0+0" suffix (checks given).
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// The three-check rules.
+type threeCheckRules struct{ standardRules }
+
+func (threeCheckRules) Variant() Variant { return VariantThreeCheck }
+func (threeCheckRules) Startpos() string { return StartposThreeCheck }
+
+func (r threeCheckRules) GenerateMoves(b *Board, piece Piece) []Move {
+	if r.Termination(b, 0) != TerminationNone { // the game is over
+		return []Move{}
+	}
+	return r.standardRules.GenerateMoves(b, piece)
+}
+
+// A check uses up one of the checks the mover has to give.
+func (threeCheckRules) Make(b *Board, h *History, captured Piece) {
+	h.gaveCheck = b.standardKingInCheck()
+	if h.gaveCheck {
+		b.useCheck(!b.Wtomove)
+	}
+}
+
+// Gives back the check.
+func (threeCheckRules) Undo(b *Board, h *History) {
+	if h.gaveCheck {
+		b.checks[sideIndex(!b.Wtomove)]++
+	}
+}
+
+func (threeCheckRules) Termination(b *Board, moveCount int) Termination {
+	if b.checks[0] == 0 || b.checks[1] == 0 {
+		return TerminationThreeChecks
+	}
+	return TerminationNone
+}
+
+func (threeCheckRules) Hash(b *Board) uint64 {
+	return checksZobristC[0][b.checks[0]] ^ checksZobristC[1][b.checks[1]]
+}
+
+func (threeCheckRules) ParseFen(b *Board, fields []string) []string {
+	b.checks, fields, _ = parseCheckCounts(fields)
+	return fields
+}
+
+// Writes the checks left after the en passant square.
+func (threeCheckRules) FormatFen(b *Board, fields []string) []string {
+	checks := strconv.Itoa(int(b.checks[0])) + "+" + strconv.Itoa(int(b.checks[1]))
+	return slices.Insert(fields, 4, checks)
+}
+
+// Returns the number of checks the given side still has to give to win in three-check.
+func (b *Board) ChecksLeft(white bool) int {
+	return int(b.checks[sideIndex(white)])
+}
+
+// Uses up one of the checks of a side, updating the hash.
+func (b *Board) useCheck(white bool) {
+	checks := &b.checks[sideIndex(white)]
+	b.hash ^= checksZobristC[sideIndex(white)][*checks]
+	*checks--
+	b.hash ^= checksZobristC[sideIndex(white)][*checks]
+}
+
+// Finds the check counts among the FEN fields, in the "3+3" or the "+0+0" form.
+// Returns the checks left for white and black, and the fields without the check counts.
+func parseCheckCounts(tokens []string) (checks [2]uint8, rest []string, ok bool) {
+	for i, token := range tokens {
+		if i < 4 || !strings.Contains(token, "+") {
+			continue
+		}
+		given := strings.HasPrefix(token, "+")
+		white, black, _ := strings.Cut(strings.TrimPrefix(token, "+"), "+")
+		w, errw := strconv.Atoi(white)
+		b, errb := strconv.Atoi(black)
+		if errw != nil || errb != nil || w < 0 || w > 3 || b < 0 || b > 3 {
+			break
+		}
+		if given {
+			w, b = 3-w, 3-b
+		}
+		rest = append(slices.Clone(tokens[:i]), tokens[i+1:]...)
+		return [2]uint8{uint8(w), uint8(b)}, rest, true
+	}
+	return [2]uint8{3, 3}, tokens, false
+}
@@ -0,0 +1,106 @@
+package dragontoothmg
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestThreeCheckStartingPosition(t *testing.T) {
+	perftSolutions := map[int]int64{
+		1: 20,
+		2: 400,
+		3: 8902,
+		4: 197281,
+		5: 4865609,
+	}
+	checkPerftResults(StartposThreeCheck, perftSolutions, t)
+}
+
+// With one check left, every checking move ends the game
+func TestThreeCheckLastCheck(t *testing.T) {
+	fen := "r1bqkbnr/pppp1ppp/2n5/4p3/2B1P3/5Q2/PPPP1PPP/RNB1K1NR w KQkq - 1+3 4 4"
+	b := ParseFen(fen)
+	standard := ParseFen("r1bqkbnr/pppp1ppp/2n5/4p3/2B1P3/5Q2/PPPP1PPP/RNB1K1NR w KQkq - 4 4")
+	var expected int64
+	for _, move := range standard.GenerateLegalMoves() {
+		standard.Make(move)
+		if !standard.OurKingInCheck() {
+			expected += Perft(&standard, 2)
+		}
+		standard.Undo()
+	}
+	if result := Perft(&b, 3); result != expected {
+		t.Error("Expected", expected, "but got", result)
+	}
+
+	b.Make(parseMove("f3f7"))
+	moves := b.GenerateLegalMoves()
+	if len(moves) != 0 || !b.IsTerminated(len(moves)) || b.Termination() != TerminationThreeChecks {
+		t.Error("Expected a three-check win, got", b.Termination())
+	}
+	if b.ChecksLeft(true) != 0 || b.ChecksLeft(false) != 3 {
+		t.Error("Wrong check counts", b.ToFen())
+	}
+	b.Undo()
+	if b.ToFen() != fen {
+		t.Error("Undo failed:", b.ToFen())
+	}
+}
+
+func TestThreeCheckFen(t *testing.T) {
+	tests := []struct {
+		fen, expected string
+	}{
+		{StartposThreeCheck, StartposThreeCheck},
+		{"rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 2+3 0 2",
+			"rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 2+3 0 2"},
+		// lichess suffix: checks given
+		{"rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 0 2 +1+2",
+			"rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 2+1 0 2"},
+	}
+	for _, test := range tests {
+		b := ParseFen(test.fen)
+		if b.Variant() != VariantThreeCheck {
+			t.Error("Check counts didn't select three-check for", test.fen)
+		}
+		if b.ToFen() != test.expected {
+			t.Error("Expected", test.expected, "but got", b.ToFen())
+		}
+	}
+
+	b1 := ParseFen("rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 2+3 0 2")
+	b2 := ParseFen("rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 3+3 0 2")
+	if b1.Hash() == b2.Hash() {
+		t.Error("Check counts are not part of the hash")
+	}
+	b3 := ParseFen("rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 0 2")
+	if b3.Variant() != VariantStandard {
+		t.Error("Standard FEN parsed as", b3.Variant())
+	}
+}
+
+// Plays random games, checking the incremental hash and that Undo restores the board
+func TestThreeCheckRandomGames(t *testing.T) {
+	rng := rand.New(rand.NewSource(34))
+	for game := 0; game < 50; game++ {
+		b := NewBoardVariant(VariantThreeCheck)
+		fens := []string{b.ToFen()}
+		for ply := 0; ply < 200; ply++ {
+			moves := b.GenerateLegalMoves()
+			if len(moves) == 0 {
+				break
+			}
+			b.Make(moves[rng.Intn(len(moves))])
+			if b.Hash() != recomputeBoardHash(b) {
+				t.Fatal("Incremental hash mismatch in", b.ToFen())
+			}
+			fens = append(fens, b.ToFen())
+		}
+		for i := len(fens) - 1; i > 0; i-- {
+			if b.ToFen() != fens[i] {
+				t.Fatal("Expected", fens[i], "but got", b.ToFen())
+			}
+			b.Undo()
+		}
+	}
+}
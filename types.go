@@ -20,6 +20,10 @@ import (
 // H8 G8 F8 E8 D8 C8 B8 A8 H7 ... A2 H1 G1 F1 E1 D1 C1 B1 A1
 
 // The board type, which uses little-endian rank-file mapping.
+// A board built by setting the White and Black bitboards directly, instead of with
+// ParseFen, has an empty mailbox, and must call SyncMailbox before Make, MoveInfo,
+// SEE or the move picker. PieceAt, IsCapture and IsPseudoLegal read the bitboards
+// when the mailbox is empty, and never modify the board.
 type Board struct {
 	Wtomove       bool
 	enpassant     uint8 // square id (16-23 or 40-47) where en passant capture is possible
@@ -29,10 +33,58 @@ type Board struct {
 	White         Bitboards
 	Black         Bitboards
 	hash          uint64
+	pawnHash      uint64 // Zobrist hash of the pawns only
+	materialKey   uint64 // Zobrist key of the piece counts
+
+	// The piece on each square, with blackPieceBit set for black pieces (0 if empty).
+	// Kept in sync with the bitboards.
+	mailbox [64]uint8
 
 	// Contains main line of the game, with additional
 	History     []History
 	termination Termination
+
+	// Variant rules in use (nil for standard chess), and their state
+	rules    Rules
+	pockets  [2]Pocket // crazyhouse pockets, white first
+	promoted uint64    // crazyhouse promoted pieces of both sides
+	checks   [2]uint8  // three-check: checks each side still has to give, white first
+}
+
+// Chess variants supported by the board.
+type Variant uint8
+
+const (
+	VariantStandard      Variant = iota
+	VariantCrazyhouse            // captured pieces go to the captor's pocket and can be dropped
+	VariantAtomic                // captures explode the pieces around the capture square
+	VariantAntichess             // captures are compulsory, and losing all the pieces wins
+	VariantThreeCheck            // giving three checks wins
+	VariantKingOfTheHill         // a king reaching the center wins
+	VariantRacingKings           // the first king to reach the 8th rank wins, giving check is illegal
+	VariantHorde                 // white has a horde of pawns and no king
+)
+
+func (v Variant) String() string {
+	switch v {
+	case VariantStandard:
+		return "standard"
+	case VariantCrazyhouse:
+		return "crazyhouse"
+	case VariantAtomic:
+		return "atomic"
+	case VariantAntichess:
+		return "antichess"
+	case VariantThreeCheck:
+		return "threecheck"
+	case VariantKingOfTheHill:
+		return "kingofthehill"
+	case VariantRacingKings:
+		return "racingkings"
+	case VariantHorde:
+		return "horde"
+	}
+	return "unknown"
 }
 
 type Termination uint16
@@ -44,6 +96,13 @@ const (
 	TerminationFiftyMovesRule       = 4
 	TerminationInsufficientMaterial = 8
 	TerminationRepetition           = 16
+	TerminationKingExploded         = 32   // atomic: the side to move lost its king
+	TerminationAntichessWin         = 64   // antichess: the side to move has no moves left, and wins
+	TerminationThreeChecks          = 128  // three-check: the opponent gave the third check
+	TerminationKingOfTheHill        = 256  // king of the hill: a king reached the center
+	TerminationRacingKings          = 512  // racing kings: a king reached the 8th rank first
+	TerminationRacingKingsDraw      = 1024 // racing kings: both kings reached the 8th rank
+	TerminationHordeDestroyed       = 2048 // horde: all the white pieces were captured
 )
 
 func (t Termination) String() string {
@@ -65,27 +124,61 @@ func (t Termination) String() string {
 	if t&TerminationInsufficientMaterial != 0 {
 		termination.WriteString("TerminationInsufficientMaterial|")
 	}
+	if t&TerminationRepetition != 0 {
+		termination.WriteString("TerminationRepetition|")
+	}
+	if t&TerminationKingExploded != 0 {
+		termination.WriteString("TerminationKingExploded|")
+	}
+	if t&TerminationAntichessWin != 0 {
+		termination.WriteString("TerminationAntichessWin|")
+	}
+	if t&TerminationThreeChecks != 0 {
+		termination.WriteString("TerminationThreeChecks|")
+	}
+	if t&TerminationKingOfTheHill != 0 {
+		termination.WriteString("TerminationKingOfTheHill|")
+	}
+	if t&TerminationRacingKings != 0 {
+		termination.WriteString("TerminationRacingKings|")
+	}
+	if t&TerminationRacingKingsDraw != 0 {
+		termination.WriteString("TerminationRacingKingsDraw|")
+	}
+	if t&TerminationHordeDestroyed != 0 {
+		termination.WriteString("TerminationHordeDestroyed|")
+	}
 
 	s := termination.String()
 	return s[:len(s)-1]
 }
 
-// Internal structure to store history for undoing moves and detecting repetitions
+// Internal structure to store history for undoing moves and detecting repetitions.
+// It holds values only, so boards can be copied along with their history.
 type History struct {
 	// Stores the hash before making the move with Make() (so that Undo() can restore it)
 	hashBefore uint64
 	// Stores the hash after making the move with Make() (so that IsRepetition can work)
 	hashCurrent uint64
+	// Pawn hash and material key before making the move
+	pawnHashBefore, materialKeyBefore uint64
+
+	Move               Move  // the move made
+	capturedPieceType  Piece // the piece captured on the target square (Nothing for en passant)
+	oldCastleRights    uint8 // castling rights before the move
+	oldEpCaptureSquare uint8 // en passant square before the move
+	oldHalfmoveClock   uint8 // halfmove clock before the move
+
+	// Crazyhouse: the piece put into the pocket, and whether the captured piece was
+	// promoted
+	pocketed         Piece
+	capturedPromoted bool
+
+	// Three-check: whether the move gave check
+	gaveCheck bool
 
-	// fields captured by original closure, many are probably redundant
-	resetHalfmoveClockFrom                                                   int     // required
-	oldRookLoc, newRookLoc                                                   uint8   // not req
-	flippedKsCastle, flippedQsCastle, flippedOppKsCastle, flippedOppQsCastle bool    // not req
-	capturedBitboard                                                         *uint64 // required but may be converted to uint8 square
-	Move                                                                     Move    // required
-	oldEpCaptureSquare                                                       uint8   // not req
-	castleStatus                                                             int
-	capturedPieceType                                                        Piece // required
+	// Atomic: exploded squares and their pieces (see explode)
+	explodedSquares, explodedPieces uint64
 }
 
 // Create a new board in the starting position.
@@ -94,6 +187,22 @@ func NewBoard() *Board {
 	return &b
 }
 
+// Create a new board of the given variant in its starting position.
+func NewBoardVariant(variant Variant) *Board {
+	return NewBoardRules(variant.Rules())
+}
+
+// Create a new board with the given rules, in their starting position.
+func NewBoardRules(rules Rules) *Board {
+	b := ParseFenRules(rules.Startpos(), rules)
+	return &b
+}
+
+// Returns the FEN of the starting position of the variant.
+func (v Variant) Startpos() string {
+	return v.Rules().Startpos()
+}
+
 // Return the Zobrist hash value for the board.
 // The hash value does NOT change with the turn number, nor the draw move counter.
 // All other elements of the Board type affect the hash.
@@ -103,7 +212,27 @@ func (b *Board) Hash() uint64 {
 	return b.hash
 }
 
+// Return the Zobrist hash of the pawn structure (pawns of both colors only).
+// Useful as a key for pawn evaluation caches. Incrementally updated.
+func (b *Board) PawnHash() uint64 {
+	return b.pawnHash
+}
+
+// Return the material key of the board. It depends only on the number of
+// pieces of each type and color, so two positions with the same material
+// share the same key. Incrementally updated.
+func (b *Board) MaterialKey() uint64 {
+	return b.materialKey
+}
+
+// Returns a human-readable material signature, such as "KRPvKR".
+// White pieces are listed first, from the king down to the pawns.
+func (b *Board) MaterialSignature() string {
+	return b.White.materialSignature() + "v" + b.Black.materialSignature()
+}
+
 // Returns true if the given move is legal in the current position.
+// Generates all the moves; IsLegalMove checks the single move instead.
 func (b *Board) IsLegal(m Move) bool {
 	return slices.Contains(b.GenerateLegalMoves(), m)
 }
@@ -126,20 +255,40 @@ func (b *Board) Termination() Termination {
 //
 // - Insufficient material
 //
+// - Exploded king, in atomic chess
+//
+// - No moves left in antichess, which wins
+//
+// - Third check given, in three-check
+//
+// - King in the center, in king of the hill
+//
+// - King on the 8th rank, in racing kings
+//
+// - No white pieces left, in horde
+//
 // The parameter 'moveCount' is the number of legal moves in the current position,
 // which can be obtained by calling 'GenerateLegalMoves()' and taking the length of the result.
+// Only whether it is zero matters, so passing 1 when 'HasLegalMove()' is true, and 0
+// otherwise, avoids generating the moves.
 // To get a more verbose termination reason, call 'Termination()' after this function.
 func (b *Board) IsTerminated(moveCount int) bool {
 	if b.Halfmoveclock >= 100 {
 		b.termination |= TerminationFiftyMovesRule
 	}
 
-	if moveCount == 0 {
-		if b.OurKingInCheck() {
-			b.termination |= TerminationCheckmate
-		} else {
-			b.termination |= TerminationStalemate
-		}
+	variantTermination := Termination(TerminationNone)
+	if b.rules != nil {
+		variantTermination = b.rules.Termination(b, moveCount)
+	}
+	switch {
+	case variantTermination != TerminationNone:
+		b.termination |= variantTermination
+	case moveCount != 0:
+	case b.OurKingInCheck():
+		b.termination |= TerminationCheckmate
+	default:
+		b.termination |= TerminationStalemate
 	}
 
 	return b.termination != TerminationNone || b.IsRepetition(3) || b.IsInsufficientMaterial()
@@ -163,10 +312,23 @@ func (b *Board) IsRepetition(nTimes int) bool {
 	return false
 }
 
-// Source https://www.chessprogramming.org/Material#InsufficientMaterial
-// According to FIDE: KB vs K is a draw, as is KN vs K and KNN vs K
+// Returns true if neither side can win anymore, by the rules of the variant.
 func (b *Board) IsInsufficientMaterial() bool {
+	var insufficient bool
+	if b.rules != nil {
+		insufficient = b.rules.InsufficientMaterial(b)
+	} else {
+		insufficient = b.standardInsufficientMaterial()
+	}
+	if insufficient {
+		b.termination |= TerminationInsufficientMaterial
+	}
+	return insufficient
+}
 
+// Source https://www.chessprogramming.org/Material#InsufficientMaterial
+// According to FIDE: KB vs K is a draw, as is KN vs K and KNN vs K
+func (b *Board) standardInsufficientMaterial() bool {
 	// If there are still rooks, queens or pawns on the board, the
 	// game isn't terminated yet
 	if (b.White.Queens|b.White.Rooks|b.White.Pawns) != 0 ||
@@ -176,21 +338,18 @@ func (b *Board) IsInsufficientMaterial() bool {
 
 	// King vs king
 	if b.White.All == b.White.Kings && b.Black.All == b.Black.Kings {
-		b.termination |= TerminationInsufficientMaterial
 		return true
 	}
 
 	// King and bishop vs king
 	if (b.White.All == (b.White.Kings|b.White.Bishops) && b.Black.All == b.Black.Kings) ||
 		(b.Black.All == (b.Black.Kings|b.Black.Bishops) && b.White.All == b.White.Kings) {
-		b.termination |= TerminationInsufficientMaterial
 		return true
 	}
 
 	// King and knight vs king
 	if (b.White.All == (b.White.Kings|b.White.Knights) && b.Black.All == b.Black.Kings) ||
 		(b.Black.All == (b.Black.Kings|b.Black.Knights) && b.White.All == b.White.Kings) {
-		b.termination |= TerminationInsufficientMaterial
 		return true
 	}
 
@@ -206,7 +365,6 @@ func (b *Board) IsInsufficientMaterial() bool {
 
 		// Check if they are on the same color
 		if (wBishopSquare.Rank()+wBishopSquare.File())%2 == (bBishopSquare.Rank()+bBishopSquare.File())%2 {
-			b.termination |= TerminationInsufficientMaterial
 			return true
 		}
 	}
@@ -227,10 +385,18 @@ func (b Board) Clone() *Board {
 		White:         b.White,
 		Black:         b.Black,
 		hash:          b.hash,
+		pawnHash:      b.pawnHash,
+		materialKey:   b.materialKey,
+		mailbox:       b.mailbox,
 
 		// Added
 		History:     history,
 		termination: b.termination,
+
+		rules:    b.rules,
+		pockets:  b.pockets,
+		promoted: b.promoted,
+		checks:   b.checks,
 	}
 }
 
@@ -339,10 +505,29 @@ type Bitboards struct {
 	All     uint64
 }
 
+// Piece letters of a side, from the king down to the pawns.
+func (bb *Bitboards) materialSignature() string {
+	var sig strings.Builder
+	counts := [...]struct {
+		bitboard uint64
+		letter   byte
+	}{
+		{bb.Kings, 'K'}, {bb.Queens, 'Q'}, {bb.Rooks, 'R'},
+		{bb.Bishops, 'B'}, {bb.Knights, 'N'}, {bb.Pawns, 'P'},
+	}
+	for _, c := range counts {
+		for i := bits.OnesCount64(c.bitboard); i > 0; i-- {
+			sig.WriteByte(c.letter)
+		}
+	}
+	return sig.String()
+}
+
 // Data stored inside, from LSB
 // 6 bits: destination square
 // 6 bits: source square
-// 3 bits: promotion
+// 3 bits: promotion (the dropped piece for drops)
+// 1 bit: drop flag
 
 // Move bitwise structure; internal implementation is private.
 type Move uint16
@@ -356,6 +541,22 @@ func (m *Move) From() uint8 {
 
 // Whether the move involves promoting a pawn.
 func (m *Move) Promote() Piece {
+	if *m&dropFlag != 0 {
+		return Nothing
+	}
+	return Piece((*m & 0x7000) >> 12)
+}
+
+// Whether the move drops a piece from the pocket (crazyhouse).
+func (m *Move) IsDrop() bool {
+	return *m&dropFlag != 0
+}
+
+// The piece dropped by a drop move, or Nothing for ordinary moves.
+func (m *Move) Dropped() Piece {
+	if *m&dropFlag == 0 {
+		return Nothing
+	}
 	return Piece((*m & 0x7000) >> 12)
 }
 func (m *Move) Setto(s Square) *Move {
@@ -370,12 +571,21 @@ func (m *Move) Setpromote(p Piece) *Move {
 	*m = *m & ^(Move(0x7000)) | (Move(p) << 12)
 	return m
 }
+
+// Turns the move into a drop of the given piece; the source square is ignored.
+func (m *Move) Setdrop(p Piece) *Move {
+	*m = *m & ^(Move(0xFFC0)) | (Move(p) << 12) | dropFlag
+	return m
+}
 func (m *Move) String() string {
 	/*return fmt.Sprintf("[from: %v, to: %v, promote: %v]",
 	IndexToAlgebraic(Square(m.From())), IndexToAlgebraic(Square(m.To())), m.Promote())*/
 	if *m == 0 {
 		return "0000"
 	}
+	if m.IsDrop() {
+		return string(pieceLetters[m.Dropped()]) + "@" + IndexToAlgebraic(Square(m.To()))
+	}
 	result := IndexToAlgebraic(Square(m.From())) + IndexToAlgebraic(Square(m.To()))
 	switch m.Promote() {
 	case Queen:
@@ -386,6 +596,8 @@ func (m *Move) String() string {
 		result += "r"
 	case Bishop:
 		result += "b"
+	case King:
+		result += "k"
 	default:
 	}
 	return result
@@ -416,3 +628,31 @@ const (
 	Queen   = iota
 	King    = iota
 )
+
+// Piece colors.
+type Color uint8
+
+const (
+	White Color = iota
+	Black
+)
+
+// Set in the mailbox for black pieces.
+const blackPieceBit = 8
+
+// Returns the piece on a square, and its color. An empty square holds Nothing.
+// This function is cheap to call, since the mailbox is incrementally updated. The
+// bitboards are scanned instead when the mailbox was never synced with them.
+func (b *Board) PieceAt(sq Square) (Piece, Color) {
+	piece := b.mailbox[sq]
+	if piece == 0 && (b.White.All|b.Black.All)&(uint64(1)<<sq) != 0 {
+		squareMask := uint64(1) << sq
+		if b.White.All&squareMask != 0 {
+			piece, _ := DeterminePieceType(&b.White, squareMask)
+			return piece, White
+		}
+		piece, _ := DeterminePieceType(&b.Black, squareMask)
+		return piece, Black
+	}
+	return Piece(piece & 7), Color(piece >> 3)
+}
@@ -0,0 +1,338 @@
+package dragontoothmg
+
+import "math/bits"
+
+// A move taken back, for retrograde analysis. It holds the move that led to
+// the position, and the piece that move captured, if any.
+// Data stored inside, from LSB:
+// 16 bits: the move
+// 3 bits: the uncaptured piece (Nothing, Pawn, ..., Queen)
+// 1 bit: whether the move was an en passant capture
+type Unmove uint32
+
+// Returns the move that led to the position.
+func (u Unmove) Move() Move {
+	return Move(u & 0xFFFF)
+}
+
+// Returns the piece that the move captured, or Nothing.
+func (u Unmove) Uncapture() Piece {
+	return Piece(u >> 16 & 0x7)
+}
+
+// Returns whether the move was an en passant capture.
+func (u Unmove) IsEnPassant() bool {
+	return u&(1<<19) != 0
+}
+
+func newUnmove(from, to uint8, promote, uncapture Piece, enPassant bool) Unmove {
+	var m Move
+	m.Setfrom(Square(from)).Setto(Square(to)).Setpromote(promote)
+	u := Unmove(m) | Unmove(uncapture)<<16
+	if enPassant {
+		u |= 1 << 19
+	}
+	return u
+}
+
+// Returns the move in long-algebraic notation, followed by "x" and the
+// uncaptured piece, and "ep" for en passant captures (for example "e5d6xPep").
+func (u Unmove) String() string {
+	m := u.Move()
+	result := m.String()
+	if u.Uncapture() != Nothing {
+		result += "x" + string(" PNBRQK"[u.Uncapture()])
+	}
+	if u.IsEnPassant() {
+		result += "ep"
+	}
+	return result
+}
+
+// Returns the bitboard of the given piece type.
+func (bb *Bitboards) pieceBitboard(p Piece) *uint64 {
+	switch p {
+	case Pawn:
+		return &bb.Pawns
+	case Knight:
+		return &bb.Knights
+	case Bishop:
+		return &bb.Bishops
+	case Rook:
+		return &bb.Rooks
+	case Queen:
+		return &bb.Queens
+	}
+	return &bb.Kings
+}
+
+// Generates the unmoves of the position: the moves that could have led to it,
+// including uncaptures of each piece type, unpromotions, un-en-passant and
+// castling reversal. The positions before them are legal: the side that
+// just moved cannot have left its king in check.
+//
+// The halfmove clock is taken into account: with a nonzero clock, the last
+// move could not be a capture or a pawn move. An en passant square means
+// the last move was the double pawn push that set it.
+// Only standard chess is supported; drops are never generated.
+func (b *Board) GenerateUnmoves() []Unmove {
+	moverIsBlack := b.Wtomove
+	mover, opp := &b.White, &b.Black
+	if moverIsBlack {
+		mover, opp = opp, mover
+	}
+	moverKing := uint8(bits.TrailingZeros64(mover.Kings))
+	if mover.Kings == 0 || b.UnderDirectAttack(!moverIsBlack, moverKing) {
+		return nil
+	}
+
+	unmoves := make([]Unmove, 0, kDefaultMoveListLength)
+	occupied := b.White.All | b.Black.All
+	forward := int8(8)
+	lastRank, homeRank := onlyRank[7], onlyRank[0]
+	if moverIsBlack {
+		forward, lastRank, homeRank = -8, onlyRank[0], onlyRank[7]
+	}
+
+	// Only the double push could set the en passant square
+	if b.enpassant != 0 {
+		to := uint8(int8(b.enpassant) + forward)
+		from := uint8(int8(b.enpassant) - forward)
+		if mover.Pawns&(1<<to) != 0 && occupied&(1<<from) == 0 {
+			unmoves = append(unmoves, newUnmove(from, to, Nothing, Nothing, false))
+		}
+		return b.legalUnmoves(unmoves)
+	}
+
+	zeroing := b.Halfmoveclock == 0
+	// Uncaptured pieces, on a square not on the first or last rank
+	uncaptures := func(to uint8) []Piece {
+		if !zeroing || bits.OnesCount64(opp.All) >= 16 {
+			return nil
+		}
+		pieces := []Piece{Knight, Bishop, Rook, Queen}
+		if (1<<to)&(onlyRank[0]|onlyRank[7]) == 0 && bits.OnesCount64(opp.Pawns) < 8 {
+			pieces = append(pieces, Pawn)
+		}
+		return pieces
+	}
+	addUnmoves := func(from, to uint8) {
+		unmoves = append(unmoves, newUnmove(from, to, Nothing, Nothing, false))
+		for _, p := range uncaptures(to) {
+			unmoves = append(unmoves, newUnmove(from, to, Nothing, p, false))
+		}
+	}
+
+	// Squares whose pieces never moved, because of the castling rights
+	var unmoved uint64
+	if b.OppCanCastleKingside() {
+		unmoved |= mover.Kings | homeRank&onlyFile[7]
+	}
+	if b.OppCanCastleQueenside() {
+		unmoved |= mover.Kings | homeRank&onlyFile[0]
+	}
+
+	// Pieces (and pieces that were promoted) going back
+	for pieces := mover.All &^ mover.Pawns &^ unmoved; pieces != 0; pieces &= pieces - 1 {
+		to := uint8(bits.TrailingZeros64(pieces))
+		piece := Piece(b.mailbox[to] & 7)
+		var origins uint64
+		switch piece {
+		case Knight:
+			origins = knightMasks[to]
+		case Bishop:
+			origins = CalculateBishopMoveBitboard(to, occupied)
+		case Rook:
+			origins = CalculateRookMoveBitboard(to, occupied)
+		case Queen:
+			origins = CalculateBishopMoveBitboard(to, occupied) | CalculateRookMoveBitboard(to, occupied)
+		case King:
+			origins = kingMasks[to]
+		}
+		for origins &^= occupied; origins != 0; origins &= origins - 1 {
+			addUnmoves(uint8(bits.TrailingZeros64(origins)), to)
+		}
+
+		// Unpromotions, straight or with a capture
+		if piece != King && zeroing && (1<<to)&lastRank != 0 {
+			from := uint8(int8(to) - forward)
+			if occupied&(1<<from) == 0 {
+				unmoves = append(unmoves, newUnmove(from, to, piece, Nothing, false))
+			}
+			for _, from := range pawnCaptureOrigins(to, forward) {
+				if occupied&(1<<from) == 0 {
+					for _, p := range uncaptures(to) {
+						unmoves = append(unmoves, newUnmove(from, to, piece, p, false))
+					}
+				}
+			}
+		}
+	}
+
+	// Pawn pushes and captures. Double pushes would have set the en passant square.
+	for pawns := mover.Pawns; zeroing && pawns != 0; pawns &= pawns - 1 {
+		to := uint8(bits.TrailingZeros64(pawns))
+		from := uint8(int8(to) - forward)
+		if occupied&(1<<from) == 0 && (1<<from)&homeRank == 0 {
+			unmoves = append(unmoves, newUnmove(from, to, Nothing, Nothing, false))
+		}
+		for _, from := range pawnCaptureOrigins(to, forward) {
+			if occupied&(1<<from) != 0 || (1<<from)&homeRank != 0 {
+				continue
+			}
+			for _, p := range uncaptures(to) {
+				unmoves = append(unmoves, newUnmove(from, to, Nothing, p, false))
+			}
+			// En passant: the captured pawn double pushed past the destination
+			captured := uint8(int8(to) - forward)
+			origin := uint8(int8(to) + forward)
+			epRank := onlyRank[5]
+			if moverIsBlack {
+				epRank = onlyRank[2]
+			}
+			if (1<<to)&epRank != 0 && occupied&(1<<captured|1<<origin) == 0 &&
+				bits.OnesCount64(opp.All) < 16 && bits.OnesCount64(opp.Pawns) < 8 {
+				unmoves = append(unmoves, newUnmove(from, to, Nothing, Pawn, true))
+			}
+		}
+	}
+
+	// Castling, if the side that moved has no castling rights left
+	if !b.OppCanCastleKingside() && !b.OppCanCastleQueenside() {
+		kingHome := uint8(4)
+		if moverIsBlack {
+			kingHome = 60
+		}
+		if mover.Kings == 1<<(kingHome+2) && mover.Rooks&(1<<(kingHome+1)) != 0 &&
+			occupied&(1<<kingHome|1<<(kingHome+3)) == 0 {
+			unmoves = append(unmoves, newUnmove(kingHome, kingHome+2, Nothing, Nothing, false))
+		}
+		if mover.Kings == 1<<(kingHome-2) && mover.Rooks&(1<<(kingHome-1)) != 0 &&
+			occupied&(1<<kingHome|1<<(kingHome-3)|1<<(kingHome-4)) == 0 {
+			unmoves = append(unmoves, newUnmove(kingHome, kingHome-2, Nothing, Nothing, false))
+		}
+	}
+	return b.legalUnmoves(unmoves)
+}
+
+// Returns the squares from which a pawn moving forward could capture on to.
+func pawnCaptureOrigins(to uint8, forward int8) []uint8 {
+	origins := make([]uint8, 0, 2)
+	if to&7 > 0 {
+		origins = append(origins, uint8(int8(to)-forward-1))
+	}
+	if to&7 < 7 {
+		origins = append(origins, uint8(int8(to)-forward+1))
+	}
+	return origins
+}
+
+// Keeps the unmoves leading to legal positions: the side to move there
+// can't capture the opponent's king, and castling can't start from or
+// pass through an attacked square.
+func (b *Board) legalUnmoves(unmoves []Unmove) []Unmove {
+	legal := unmoves[:0]
+	for _, u := range unmoves {
+		prev := *b
+		prev.Unmake(u)
+		// Now prev.Wtomove is the side that moved
+		them := prev.Black.Kings
+		if !prev.Wtomove {
+			them = prev.White.Kings
+		}
+		if prev.UnderDirectAttack(!prev.Wtomove, uint8(bits.TrailingZeros64(them))) {
+			continue
+		}
+		m := u.Move()
+		distance := int(m.To()) - int(m.From())
+		if (prev.White.Kings|prev.Black.Kings)&(1<<m.From()) != 0 && (distance == 2 || distance == -2) {
+			// Castling: the king doesn't start from, or pass through, an attacked square
+			passed := (m.From() + m.To()) / 2
+			if prev.UnderDirectAttack(prev.Wtomove, m.From()) || prev.UnderDirectAttack(prev.Wtomove, passed) {
+				continue
+			}
+		}
+		legal = append(legal, u)
+	}
+	return legal
+}
+
+// Takes back an unmove generated by GenerateUnmoves. Information that the
+// position doesn't hold is reset: the position before the move has no en
+// passant square (unless the move was an en passant capture), only the
+// castling right used by a castling move is restored, and the halfmove clock
+// is set to zero before captures and pawn moves. The move history is cleared,
+// since the previous position is not part of it.
+func (b *Board) Unmake(u Unmove) {
+	m := u.Move()
+	mover, opp := &b.White, &b.Black
+	forward := int8(8)
+	if b.Wtomove {
+		mover, opp = opp, mover
+		forward = -8
+	}
+	fromBitboard, toBitboard := uint64(1)<<m.From(), uint64(1)<<m.To()
+
+	// Move the piece back, as a pawn if it was promoted
+	piece := Piece(b.mailbox[m.To()] & 7)
+	pieceBitboard := mover.pieceBitboard(piece)
+	*pieceBitboard &^= toBitboard
+	if m.Promote() != Nothing {
+		mover.Pawns |= fromBitboard
+	} else {
+		*pieceBitboard |= fromBitboard
+	}
+	mover.All ^= fromBitboard | toBitboard
+
+	// Move the castling rook back, and restore the castling right
+	if piece == King && (m.To()-m.From() == 2 || int(m.To())-int(m.From()) == -2) {
+		var rookFrom, rookTo uint8
+		if m.To() > m.From() {
+			rookFrom, rookTo = m.To()+1, m.To()-1
+		} else {
+			rookFrom, rookTo = m.To()-2, m.To()+1
+		}
+		mover.Rooks ^= 1<<rookFrom | 1<<rookTo
+		mover.All ^= 1<<rookFrom | 1<<rookTo
+		switch {
+		case !b.Wtomove && m.To() > m.From():
+			b.castlerights |= 1 << 1
+		case !b.Wtomove:
+			b.castlerights |= 1
+		case m.To() > m.From():
+			b.castlerights |= 1 << 3
+		default:
+			b.castlerights |= 1 << 2
+		}
+	}
+
+	// Put the captured piece back
+	b.enpassant = 0
+	if captured := u.Uncapture(); captured != Nothing {
+		square := toBitboard
+		if u.IsEnPassant() {
+			square = uint64(1) << uint8(int8(m.To())-forward)
+			b.enpassant = m.To()
+		}
+		*opp.pieceBitboard(captured) |= square
+		opp.All |= square
+	}
+
+	if piece == Pawn || m.Promote() != Nothing || u.Uncapture() != Nothing {
+		b.Halfmoveclock = 0
+	} else if b.Halfmoveclock > 0 {
+		b.Halfmoveclock--
+	}
+	if b.Wtomove && b.Fullmoveno > 1 { // black moved
+		b.Fullmoveno--
+	}
+	b.Wtomove = !b.Wtomove
+
+	b.SyncMailbox()
+	b.hash = recomputeBoardHash(b)
+	b.pawnHash = recomputePawnHash(b)
+	b.materialKey = recomputeMaterialKey(b)
+	b.History = make([]History, 1, 32)
+	b.History[0].hashCurrent = b.hash
+	b.termination = TerminationNone
+}
@@ -0,0 +1,135 @@
+package dragontoothmg
+
+import (
+	"testing"
+)
+
+func samePosition(a, b *Board) bool {
+	return a.White == b.White && a.Black == b.Black && a.Wtomove == b.Wtomove
+}
+
+// Checks that every move can be taken back, and that every unmove can be
+// played again, in the positions of small trees.
+func TestUnmovesInverseOfMoves(t *testing.T) {
+	fens := []string{
+		Startpos,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 0",
+		"n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1",
+		"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 0",
+		"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3",
+	}
+	for _, fen := range fens {
+		b := ParseFen(fen)
+		checkUnmoves(t, &b, 2)
+	}
+}
+
+func checkUnmoves(t *testing.T, b *Board, depth int) {
+	if depth == 0 {
+		return
+	}
+	for _, m := range b.GenerateLegalMoves() {
+		before := *b
+		b.Make(m)
+		found := false
+		for _, u := range b.GenerateUnmoves() {
+			if u.Move() != m {
+				continue
+			}
+			prev := *b
+			prev.Unmake(u)
+			found = found || samePosition(&prev, &before)
+		}
+		if !found {
+			t.Error("Move", &m, "from", before.ToFen(), "has no unmove back")
+		}
+		checkReplay(t, b)
+		checkUnmoves(t, b, depth-1)
+		b.Undo()
+	}
+}
+
+// Checks that the unmoves of a position lead to positions where the move is
+// legal and gives the position back.
+func checkReplay(t *testing.T, b *Board) {
+	for _, u := range b.GenerateUnmoves() {
+		prev := *b
+		prev.Unmake(u)
+		m := u.Move()
+		legal := false
+		for _, other := range prev.GenerateLegalMoves() {
+			legal = legal || other == m
+		}
+		if !legal {
+			t.Error("Unmove", u.String(), "from", b.ToFen(), "gives", prev.ToFen(), "where it isn't legal")
+			continue
+		}
+		prev.Make(m)
+		if !samePosition(&prev, b) {
+			t.Error("Unmove", u.String(), "from", b.ToFen(), "is not undone by its move")
+		}
+	}
+}
+
+func TestGenerateUnmoves(t *testing.T) {
+	tests := []struct {
+		fen      string
+		contains []string
+		count    int // -1 if not checked
+	}{
+		// After 1. e4 only the double push is possible
+		{"rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1", []string{"e2e4"}, 1},
+		// Castling reversal
+		{"4k3/8/8/8/8/8/8/5RK1 b - - 1 1", []string{"e1g1", "f2g1", "a1f1"}, -1},
+		{"2kr4/8/8/8/8/8/8/4K3 w - - 1 1", []string{"e8c8"}, -1},
+		// Unpromotions, straight and with a capture
+		{"3Q3k/8/8/8/8/8/8/K7 b - - 0 1", []string{"d7d8q", "c7d8qxR", "e7d8qxN"}, -1},
+		// Un-en-passant
+		{"4k3/8/3P4/8/8/8/8/4K3 b - - 0 1", []string{"e5d6xPep", "c5d6xPep", "d5d6", "e5d6xB"}, -1},
+		// A nonzero halfmove clock rules out captures and pawn moves
+		{"4k3/8/3P4/8/8/8/8/4K3 b - - 3 1", []string{"d1e1"}, 5},
+		// The side that just moved can't be in check
+		{"4k3/8/8/8/8/8/8/R3K2r b - - 0 1", nil, 0},
+		// The kings and rooks keeping their castling rights never moved
+		{"r3k2r/8/8/8/8/8/8/R3K2R b KQkq - 1 1", nil, 0},
+	}
+	for _, test := range tests {
+		b := ParseFen(test.fen)
+		unmoves := b.GenerateUnmoves()
+		names := map[string]bool{}
+		for _, u := range unmoves {
+			names[u.String()] = true
+		}
+		for _, name := range test.contains {
+			if !names[name] {
+				t.Error("Expected unmove", name, "in", test.fen, "got", names)
+			}
+		}
+		if test.count >= 0 && len(unmoves) != test.count {
+			t.Error("Expected", test.count, "unmoves in", test.fen, "got", names)
+		}
+		checkReplay(t, &b)
+	}
+}
+
+func TestUnmake(t *testing.T) {
+	b := ParseFen("4k3/8/8/8/8/8/8/5RK1 b - - 1 12")
+	b.Unmake(newUnmove(4, 6, Nothing, Nothing, false))
+	if b.ToFen() != "4k3/8/8/8/8/8/8/4K2R w K - 0 12" {
+		t.Error("Castling reversal gave", b.ToFen())
+	}
+	if b.Hash() != recomputeBoardHash(&b) || b.PawnHash() != recomputePawnHash(&b) ||
+		b.MaterialKey() != recomputeMaterialKey(&b) {
+		t.Error("Hashes not updated by Unmake")
+	}
+
+	b = ParseFen("4k3/8/3P4/8/8/8/8/4K3 b - - 0 30")
+	b.Unmake(newUnmove(36, 43, Nothing, Pawn, true))
+	if b.ToFen() != "4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 30" {
+		t.Error("Un-en-passant gave", b.ToFen())
+	}
+	b.Unmake(newUnmove(51, 35, Nothing, Nothing, false))
+	if b.ToFen() != "4k3/3p4/8/4P3/8/8/8/4K3 b - - 0 29" {
+		t.Error("Undoing a double push gave", b.ToFen())
+	}
+}
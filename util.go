@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math/bits"
 	"strconv"
 	"strings"
 )
@@ -26,6 +27,9 @@ func recomputeBoardHash(b *Board) uint64 {
 		hash ^= castleRightsZobristC[3]
 	}
 	hash ^= uint64(b.enpassant)
+	if b.rules != nil {
+		hash ^= b.rules.Hash(b)
+	}
 	for i := uint8(0); i < 64; i++ {
 		whitePiece, _ := DeterminePieceType(&(b.White), uint64(1)<<i)
 		blackPiece, _ := DeterminePieceType(&(b.Black), uint64(1)<<i)
@@ -39,7 +43,53 @@ func recomputeBoardHash(b *Board) uint64 {
 	return hash
 }
 
+// Rebuilds the mailbox of the board from its bitboards. Boards built by setting
+// the White and Black bitboards directly must call it before making moves.
+func (b *Board) SyncMailbox() {
+	b.mailbox = [64]uint8{}
+	for color, bb := range [2]*Bitboards{&b.White, &b.Black} {
+		for p := Piece(Pawn); p <= King; p++ {
+			for pieces := *bb.pieceBitboard(p); pieces != 0; pieces &= pieces - 1 {
+				b.mailbox[bits.TrailingZeros64(pieces)] = uint8(p) | uint8(color*blackPieceBit)
+			}
+		}
+	}
+}
+
+// Computes the Zobrist hash of the pawns only, from scratch.
+func recomputePawnHash(b *Board) uint64 {
+	var hash uint64 = 0
+	for pawns := b.White.Pawns; pawns != 0; pawns &= pawns - 1 {
+		hash ^= pieceSquareZobristC[Pawn-1][bits.TrailingZeros64(pawns)]
+	}
+	for pawns := b.Black.Pawns; pawns != 0; pawns &= pawns - 1 {
+		hash ^= pieceSquareZobristC[Pawn+5][bits.TrailingZeros64(pawns)]
+	}
+	return hash
+}
+
+// Computes the material key from scratch. The key of a position holding n pieces
+// of one type and color is the xor of the first n material constants of that piece.
+func recomputeMaterialKey(b *Board) uint64 {
+	var key uint64 = 0
+	for i, bb := range [2]*Bitboards{&b.White, &b.Black} {
+		counts := [6]int{
+			bits.OnesCount64(bb.Pawns), bits.OnesCount64(bb.Knights), bits.OnesCount64(bb.Bishops),
+			bits.OnesCount64(bb.Rooks), bits.OnesCount64(bb.Queens), bits.OnesCount64(bb.Kings),
+		}
+		for piece, count := range counts {
+			for j := 0; j < count; j++ {
+				key ^= materialZobristC[i*6+piece][j]
+			}
+		}
+	}
+	return key
+}
+
 func IsCapture(m Move, b *Board) bool {
+	if m.IsDrop() {
+		return false
+	}
 	toBitboard := (uint64(1) << m.To())
 	if (toBitboard&b.White.All != 0) || (toBitboard&b.Black.All != 0) {
 		return true
@@ -47,43 +97,13 @@ func IsCapture(m Move, b *Board) bool {
 	// Is it an en passant capture?
 	fromBitboard := (uint64(1) << m.From())
 	originIsPawn := fromBitboard&b.White.Pawns != 0 || fromBitboard&b.Black.Pawns != 0
-	return originIsPawn && (toBitboard&(uint64(1)<<b.enpassant) != 0)
+	return originIsPawn && b.enpassant != 0 && m.To() == b.enpassant
 }
 
+// Returns the piece type on a square, and whether it is white (false for an empty square).
 func GetPieceType(square uint8, b *Board) (int, bool) {
-	squareMask := uint64(1) << square
-	var isWhite bool = true
-	if b.White.All&squareMask != 0 {
-		if b.White.Pawns&squareMask != 0 {
-			return Pawn, isWhite
-		} else if b.White.Knights&squareMask != 0 {
-			return Knight, isWhite
-		} else if b.White.Bishops&squareMask != 0 {
-			return Bishop, isWhite
-		} else if b.White.Rooks&squareMask != 0 {
-			return Rook, isWhite
-		} else if b.White.Queens&squareMask != 0 {
-			return Queen, isWhite
-		} else if b.White.Kings&squareMask != 0 {
-			return King, isWhite
-		}
-	} else {
-		isWhite = false
-		if b.Black.Pawns&squareMask != 0 {
-			return Pawn, isWhite
-		} else if b.Black.Knights&squareMask != 0 {
-			return Knight, isWhite
-		} else if b.Black.Bishops&squareMask != 0 {
-			return Bishop, isWhite
-		} else if b.Black.Rooks&squareMask != 0 {
-			return Rook, isWhite
-		} else if b.Black.Queens&squareMask != 0 {
-			return Queen, isWhite
-		} else if b.Black.Kings&squareMask != 0 {
-			return King, isWhite
-		}
-	}
-	return Nothing, isWhite
+	piece, color := b.PieceAt(Square(square))
+	return int(piece), piece != Nothing && color == White
 }
 
 // A testing-use function that ignores the error output
@@ -102,13 +122,22 @@ func (b *Bitboards) sanityCheck() {
 }
 
 // Some example valid move strings:
-// e1e2 b4d6 e7e8q a2a1n
+// e1e2 b4d6 e7e8q a2a1n P@e4
 // TODO(dylhunn): Make the parser more forgiving. Eg: 0-0, O-O-O, a2-a3, D3D4
 func ParseMove(movestr string) (Move, error) {
 	if movestr == "0000" {
 		return 0, nil
 	}
 	var mv Move
+	if len(movestr) == 4 && movestr[1] == '@' { // crazyhouse drop, such as N@f3
+		p := strings.IndexByte(pieceLetters, movestr[0])
+		to, err := AlgebraicToIndex(movestr[2:4])
+		if p < Pawn || p > Queen || err != nil {
+			return mv, errors.New("Invalid drop to parse.")
+		}
+		mv.Setto(Square(to)).Setdrop(Piece(p))
+		return mv, nil
+	}
 	if len(movestr) < 4 || len(movestr) > 5 {
 		return mv, errors.New("Invalid move to parse.")
 	}
@@ -128,6 +157,8 @@ func ParseMove(movestr string) (Move, error) {
 			mv.Setpromote(Queen)
 		case 'r':
 			mv.Setpromote(Rook)
+		case 'k': // antichess
+			mv.Setpromote(King)
 		default:
 			return mv, errors.New("Invalid promotion symbol in move.")
 		}
@@ -168,8 +199,8 @@ func algebraicToIndexFatal(alg string) uint8 {
 }
 
 // Accepts a short algebraic notation chess move, and converts it to a Move
-// Ignores check/mate indicators (+/#), but supports captures (x) and promotions (=Q).
-// Example inputs: "e4", "Nf3", "Raxd1", "e8=Q+", "O-O", "O-O-O", "Qxe5#"
+// Ignores check/mate indicators (+/#), but supports captures (x), promotions (=Q) and drops (N@f3).
+// Example inputs: "e4", "Nf3", "Raxd1", "e8=Q+", "O-O", "O-O-O", "Qxe5#", "N@f3"
 func ShortAlgebraicToMove(salg string, board *Board) (Move, error) {
 
 	// Move format:
@@ -184,18 +215,13 @@ func ShortAlgebraicToMove(salg string, board *Board) (Move, error) {
 		NotSet            = uint8(127)
 	)
 
-	var ourBB *Bitboards = &board.White
-	if !board.Wtomove {
-		ourBB = &board.Black
-	}
-
 	if salg == CastlingKingside || salg == CastlingQueenside {
 		// Castling, simply check king moves (optimized with 'kingCastlingMoves')
 		moves := make([]Move, 0, 2)
-		board.kingCastlingMoves(&moves)
+		board.kingCastlingMoves(&moves, everything)
 
 		for _, move := range moves {
-			if t, _ := DeterminePieceType(ourBB, uint64(1)<<move.From()); t == King {
+			if t, _ := board.PieceAt(Square(move.From())); t == King {
 				fromFile := Square(move.From()).File()
 				toFile := Square(move.To()).File()
 				if salg == CastlingKingside && toFile > fromFile {
@@ -210,6 +236,24 @@ func ShortAlgebraicToMove(salg string, board *Board) (Move, error) {
 		return NullMove, fmt.Errorf("castling move not found: %s", salg)
 	}
 
+	// Crazyhouse drop, such as "N@f3", or "@e4" for a pawn
+	if at := strings.IndexByte(salg, '@'); at == 0 || at == 1 {
+		pieceType := Piece(Pawn)
+		if at == 1 {
+			pieceType = Piece(strings.IndexByte(pieceLetters, salg[0]))
+		}
+		if at+3 <= len(salg) {
+			if to, err := AlgebraicToIndex(salg[at+1 : at+3]); err == nil {
+				var move Move
+				move.Setto(Square(to)).Setdrop(pieceType)
+				if board.IsLegal(move) {
+					return move, nil
+				}
+			}
+		}
+		return NullMove, fmt.Errorf("drop not found: %s", salg)
+	}
+
 	// First letter is piece type (if absent, it's a pawn)
 	pieceType := Piece(Pawn)
 	switch salg[0] {
@@ -304,7 +348,7 @@ func ShortAlgebraicToMove(salg string, board *Board) (Move, error) {
 				continue
 			}
 
-			t, _ := DeterminePieceType(ourBB, uint64(1)<<move.From())
+			t, _ := board.PieceAt(Square(move.From()))
 			if t == pieceType {
 				// Check from_file and from_rank if specified
 				if from_file != NotSet && Square(move.From()).File() != from_file {
@@ -441,6 +485,9 @@ func (b *Board) ToFen() string {
 		} else {
 			empty++
 		}
+		if toprint != "" && b.promoted&currMask != 0 {
+			toprint += "~"
+		}
 		if toprint != "" {
 			if empty != 0 {
 				position += strconv.Itoa(empty)
@@ -492,14 +539,44 @@ func (b *Board) ToFen() string {
 		position += "-"
 	}
 	position = position + " " + strconv.Itoa(int(b.Halfmoveclock)) + " " + strconv.Itoa(int(b.Fullmoveno))
+	if b.rules != nil {
+		position = strings.Join(b.rules.FormatFen(b, strings.Fields(position)), " ")
+	}
 	return position
 }
 
-// Parse a board from a FEN string.
+// Parse a board from a FEN string. A crazyhouse pocket in brackets after the
+// piece placement, such as "[Qnp]", selects the crazyhouse variant, and
+// three-check counts, such as "3+3" or "+0+0", select three-check.
 func ParseFen(fen string) Board {
+	variant := Variant(VariantStandard)
+	if strings.Contains(fen, "[") {
+		variant = VariantCrazyhouse
+	} else if _, _, ok := parseCheckCounts(strings.Fields(fen)); ok {
+		variant = VariantThreeCheck
+	}
+	return ParseFenVariant(fen, variant)
+}
+
+// Parse a board of the given variant from a FEN string.
+// In crazyhouse, the pockets are written in brackets after the piece placement,
+// and promoted pieces are marked with a '~' after their letter. In three-check,
+// the checks left are written after the en passant square, such as "3+3", or the
+// checks given are appended as in lichess, such as "+0+0".
+func ParseFenVariant(fen string, variant Variant) Board {
+	return ParseFenRules(fen, variant.Rules())
+}
+
+// Parse a board played with the given rules from a FEN string,
+// which may contain the FEN extensions of the rules.
+func ParseFenRules(fen string, rules Rules) Board {
 	// BUG(dylhunn): This FEN parsing implementation doesn't handle malformed inputs.
 	tokens := strings.Fields(fen)
 	var b Board
+	b.rules = boardRules(rules)
+	if b.rules != nil {
+		tokens = b.rules.ParseFen(&b, tokens)
+	}
 	// replace digits with the appropriate number of dashes
 	for i := 1; i <= 8; i++ {
 		var replacement string
@@ -519,8 +596,16 @@ func ParseFen(fen string) Board {
 		tokens[0] += ranks[i]
 	}
 	// add every piece to the board
-	for i := uint8(0); i < 64; i++ {
-		switch tokens[0][i] {
+	i := uint8(0)
+	for _, c := range []byte(tokens[0]) {
+		if c == '~' { // the previous piece is promoted
+			b.promoted |= 1 << (i - 1)
+			continue
+		}
+		if i >= 64 {
+			break
+		}
+		switch c {
 		case 'p':
 			b.Black.Pawns |= 1 << i
 		case 'n':
@@ -546,6 +631,7 @@ func ParseFen(fen string) Board {
 		case 'K':
 			b.White.Kings |= 1 << i
 		}
+		i++
 	}
 	b.White.All = b.White.Pawns | b.White.Knights | b.White.Bishops | b.White.Rooks | b.White.Queens | b.White.Kings
 	b.Black.All = b.Black.Pawns | b.Black.Knights | b.Black.Bishops | b.Black.Rooks | b.Black.Queens | b.Black.Kings
@@ -581,7 +667,10 @@ func ParseFen(fen string) Board {
 		result, _ := strconv.Atoi(tokens[5])
 		b.Fullmoveno = uint16(result)
 	}
+	b.SyncMailbox()
 	b.hash = recomputeBoardHash(&b)
+	b.pawnHash = recomputePawnHash(&b)
+	b.materialKey = recomputeMaterialKey(&b)
 
 	b.History = make([]History, 1, 32)
 	b.History[0].hashCurrent = b.hash